@@ -38,8 +38,8 @@ type Group struct {
 	// name
 	Name string `json:"name,omitempty"`
 
-	// policy
-	Policy string `json:"policy,omitempty"`
+	// policies attached to the group
+	Policy []string `json:"policy"`
 
 	// status
 	Status string `json:"status,omitempty"`
@@ -38,7 +38,7 @@ type ListBucketsResponse struct {
 	// list of resulting buckets
 	Buckets []*Bucket `json:"buckets"`
 
-	// number of buckets accessible to tenant user
+	// number of buckets accessible to tenant user matching the search before pagination
 	Total int64 `json:"total,omitempty"`
 }
 
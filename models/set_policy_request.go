@@ -23,6 +23,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -41,6 +43,13 @@ type SetPolicyRequest struct {
 	// entity type
 	// Required: true
 	EntityType PolicyEntity `json:"entityType"`
+
+	// replace attaches only the given policies, add and remove keep the rest of the attached policies
+	// Enum: [replace add remove]
+	Mode string `json:"mode,omitempty"`
+
+	// policies set along with the ones named on the path
+	Policies []string `json:"policies"`
 }
 
 // Validate validates this set policy request
@@ -55,6 +64,10 @@ func (m *SetPolicyRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -82,6 +95,52 @@ func (m *SetPolicyRequest) validateEntityType(formats strfmt.Registry) error {
 	return nil
 }
 
+var setPolicyRequestTypeModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["replace","add","remove"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		setPolicyRequestTypeModePropEnum = append(setPolicyRequestTypeModePropEnum, v)
+	}
+}
+
+const (
+
+	// SetPolicyRequestModeReplace captures enum value "replace"
+	SetPolicyRequestModeReplace string = "replace"
+
+	// SetPolicyRequestModeAdd captures enum value "add"
+	SetPolicyRequestModeAdd string = "add"
+
+	// SetPolicyRequestModeRemove captures enum value "remove"
+	SetPolicyRequestModeRemove string = "remove"
+)
+
+// prop value enum
+func (m *SetPolicyRequest) validateModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, setPolicyRequestTypeModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SetPolicyRequest) validateMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Mode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateModeEnum("mode", "body", m.Mode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *SetPolicyRequest) MarshalBinary() ([]byte, error) {
 	if m == nil {
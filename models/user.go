@@ -38,8 +38,8 @@ type User struct {
 	// member of
 	MemberOf []string `json:"memberOf"`
 
-	// policy
-	Policy string `json:"policy,omitempty"`
+	// policies attached to the user
+	Policy []string `json:"policy"`
 
 	// status
 	Status string `json:"status,omitempty"`
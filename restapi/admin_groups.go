@@ -136,7 +136,7 @@ func getGroupInfoResponse(session *models.Principal, params admin_api.GroupInfoP
 	groupResponse := &models.Group{
 		Members: groupDesc.Members,
 		Name:    groupDesc.Name,
-		Policy:  groupDesc.Policy,
+		Policy:  splitPolicyNames(groupDesc.Policy),
 		Status:  groupDesc.Status}
 
 	return groupResponse, nil
@@ -306,7 +306,7 @@ func getUpdateGroupResponse(session *models.Principal, params admin_api.UpdateGr
 	groupResponse := &models.Group{
 		Name:    groupUpdated.Name,
 		Members: groupUpdated.Members,
-		Policy:  groupUpdated.Policy,
+		Policy:  splitPolicyNames(groupUpdated.Policy),
 		Status:  groupUpdated.Status,
 	}
 	return groupResponse, nil
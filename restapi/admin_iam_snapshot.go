@@ -0,0 +1,528 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// iamSnapshotVersion is the version of the snapshot format, it has to be increased on incompatible changes
+const iamSnapshotVersion = 1
+
+// iamBuiltinPolicies are the canned policies shipped with MinIO, a replace import never removes them
+var iamBuiltinPolicies = []string{"readonly", "readwrite", "writeonly", "diagnostics"}
+
+func registerIAMSnapshotHandlers(api *operations.ConsoleAPI) {
+	// Export IAM snapshot
+	api.AdminAPIExportIamSnapshotHandler = admin_api.ExportIamSnapshotHandlerFunc(func(params admin_api.ExportIamSnapshotParams, session *models.Principal) middleware.Responder {
+		snapshot, err := getExportIAMSnapshotResponse(session)
+		if err != nil {
+			return admin_api.NewExportIamSnapshotDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewExportIamSnapshotOK().WithPayload(snapshot)
+	})
+	// Import IAM snapshot
+	api.AdminAPIImportIamSnapshotHandler = admin_api.ImportIamSnapshotHandlerFunc(func(params admin_api.ImportIamSnapshotParams, session *models.Principal) middleware.Responder {
+		importResponse, err := getImportIAMSnapshotResponse(session, params)
+		if err != nil {
+			return admin_api.NewImportIamSnapshotDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewImportIamSnapshotOK().WithPayload(importResponse)
+	})
+}
+
+// readIAMSnapshot reads the canned policies, users and groups of the server sorted by name,
+// secret keys of the users can't be read and are never part of the snapshot
+func readIAMSnapshot(ctx context.Context, client MinioAdmin) (*models.IamSnapshot, error) {
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &models.IamSnapshot{
+		Policies: []*models.IamSnapshotPolicy{},
+		Users:    []*models.IamSnapshotUser{},
+		Groups:   []*models.IamSnapshotGroup{},
+	}
+
+	var policyNames []string
+	for name := range policies {
+		policyNames = append(policyNames, name)
+	}
+	sort.Strings(policyNames)
+	for _, name := range policyNames {
+		rawPolicy, err := json.Marshal(policies[name])
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Policies = append(snapshot.Policies, &models.IamSnapshotPolicy{Name: name, Policy: string(rawPolicy)})
+	}
+
+	var accessKeys []string
+	for accessKey := range users {
+		accessKeys = append(accessKeys, accessKey)
+	}
+	sort.Strings(accessKeys)
+	for _, accessKey := range accessKeys {
+		snapshot.Users = append(snapshot.Users, &models.IamSnapshotUser{
+			AccessKey: accessKey,
+			Status:    string(users[accessKey].Status),
+			Policies:  append([]string{}, splitPolicyNames(users[accessKey].PolicyName)...),
+		})
+	}
+
+	groups = append([]string{}, groups...)
+	sort.Strings(groups)
+	for _, groupName := range groups {
+		group, err := client.getGroupDescription(ctx, groupName)
+		if err != nil {
+			return nil, errors.New(500, "error getting group %s: %v", groupName, err)
+		}
+		snapshot.Groups = append(snapshot.Groups, &models.IamSnapshotGroup{
+			Name:     groupName,
+			Status:   group.Status,
+			Members:  append([]string{}, group.Members...),
+			Policies: append([]string{}, splitPolicyNames(group.Policy)...),
+		})
+	}
+	return snapshot, nil
+}
+
+// exportIAMSnapshot returns the IAM of the server as a versioned snapshot
+func exportIAMSnapshot(ctx context.Context, client MinioAdmin, now time.Time) (*models.IamSnapshot, error) {
+	snapshot, err := readIAMSnapshot(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Version = iamSnapshotVersion
+	snapshot.CreatedAt = now.UTC().Format(time.RFC3339)
+	return snapshot, nil
+}
+
+// iamSnapshotChange is a change needed to apply a snapshot along with the steps performing it
+type iamSnapshotChange struct {
+	*models.IamSnapshotChange
+	steps []func(ctx context.Context, client MinioAdmin) error
+	// access and secret key of a user created with a generated secret
+	credentials []string
+}
+
+// apply performs the steps of the change in order returning how many of them succeeded
+func (c iamSnapshotChange) apply(ctx context.Context, client MinioAdmin) (int, error) {
+	for i, step := range c.steps {
+		if err := step(ctx, client); err != nil {
+			return i, errors.New(500, "error applying %s of %s %s: %v", c.Action, c.Entity, c.Name, err)
+		}
+	}
+	return len(c.steps), nil
+}
+
+// samePolicy compares two policies ignoring the order of their statements
+func samePolicy(a, b *iampolicy.Policy) bool {
+	if len(a.Statements) != len(b.Statements) {
+		return false
+	}
+statements:
+	for _, statement := range a.Statements {
+		for _, other := range b.Statements {
+			if sameStatement(statement, other) {
+				continue statements
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// describePolicyNames lists policy names on the changes descriptions
+func describePolicyNames(names []string) string {
+	if len(names) == 0 {
+		return "policies: none"
+	}
+	return "policies: " + strings.Join(names, ", ")
+}
+
+// validateIAMSnapshotStatus accepts an empty status, meaning the current one is kept
+func validateIAMSnapshotStatus(entity, name, status string) error {
+	switch status {
+	case "", "enabled", "disabled":
+		return nil
+	}
+	return errors.New(500, "error %s %s has an invalid status %s", entity, name, status)
+}
+
+// planIAMSnapshotImport compares the snapshot with the IAM of the server and returns the changes needed to apply it.
+// Policies are created first so they can be assigned to users and groups, and users before groups so they can
+// be added as members. With the replace strategy the groups, users and policies missing on the snapshot, and the
+// members missing on its groups, are removed at the end, otherwise they are kept along with the policies of
+// users and groups without any on the snapshot. Users missing on the server need a secret key, which exports
+// don't have, so one is generated for them if generateSecrets is set.
+// The whole snapshot is validated before returning so an import never fails half way because of its content.
+func planIAMSnapshotImport(ctx context.Context, client MinioAdmin, snapshot *models.IamSnapshot, strategy string, generateSecrets bool) ([]iamSnapshotChange, error) {
+	if snapshot.Version != iamSnapshotVersion {
+		return nil, errors.New(500, "error unsupported IAM snapshot version %d", snapshot.Version)
+	}
+	replace := strategy == models.IamSnapshotImportRequestStrategyReplace
+	current, err := readIAMSnapshot(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	currentPolicies := map[string]*iampolicy.Policy{}
+	availablePolicies := map[string]bool{}
+	for _, p := range current.Policies {
+		policy, err := iampolicy.ParseConfig(strings.NewReader(p.Policy))
+		if err != nil {
+			return nil, err
+		}
+		currentPolicies[p.Name] = policy
+		availablePolicies[p.Name] = !replace || IsElementInArray(iamBuiltinPolicies, p.Name)
+	}
+	currentUsers := map[string]*models.IamSnapshotUser{}
+	availableUsers := map[string]bool{}
+	for _, user := range current.Users {
+		currentUsers[user.AccessKey] = user
+		availableUsers[user.AccessKey] = !replace
+	}
+	currentGroups := map[string]*models.IamSnapshotGroup{}
+	for _, group := range current.Groups {
+		currentGroups[group.Name] = group
+	}
+
+	var changes []iamSnapshotChange
+	newChange := func(entity, name, action string, details []string) *models.IamSnapshotChange {
+		return &models.IamSnapshotChange{Entity: entity, Name: name, Action: action, Description: strings.Join(details, "; ")}
+	}
+
+	for _, p := range snapshot.Policies {
+		policy, err := iampolicy.ParseConfig(strings.NewReader(p.Policy))
+		if err != nil {
+			return nil, errors.New(500, "error parsing policy %s: %v", p.Name, err)
+		}
+		availablePolicies[p.Name] = true
+		currentPolicy, exists := currentPolicies[p.Name]
+		if exists && samePolicy(policy, currentPolicy) {
+			continue
+		}
+		action, details := models.IamSnapshotChangeActionCreate, []string(nil)
+		if exists {
+			action, details = models.IamSnapshotChangeActionUpdate, []string{"statements changed"}
+		}
+		name := p.Name
+		changes = append(changes, iamSnapshotChange{
+			IamSnapshotChange: newChange(models.IamSnapshotChangeEntityPolicy, name, action, details),
+			steps: []func(ctx context.Context, client MinioAdmin) error{
+				func(ctx context.Context, client MinioAdmin) error {
+					return client.addPolicy(ctx, name, policy)
+				},
+			},
+		})
+	}
+
+	for _, user := range snapshot.Users {
+		if err := validateIAMSnapshotStatus("user", user.AccessKey, user.Status); err != nil {
+			return nil, err
+		}
+		for _, policyName := range user.Policies {
+			if !availablePolicies[policyName] {
+				return nil, errors.New(500, "error policy %s of user %s doesn't exist", policyName, user.AccessKey)
+			}
+		}
+		availableUsers[user.AccessKey] = true
+		accessKey, secretKey, status, policies := user.AccessKey, user.SecretKey, user.Status, user.Policies
+
+		change := iamSnapshotChange{}
+		action := models.IamSnapshotChangeActionUpdate
+		var details []string
+		currentStatus, currentPolicyNames := string(madmin.AccountEnabled), []string(nil)
+		if currentUser, exists := currentUsers[accessKey]; exists {
+			currentStatus, currentPolicyNames = currentUser.Status, currentUser.Policies
+		} else {
+			if secretKey == "" {
+				if !generateSecrets {
+					return nil, errors.New(500, "error user %s doesn't exist and no secret key was provided", accessKey)
+				}
+				secretKey = RandomCharString(generatedSecretKeyLength)
+				change.credentials = []string{accessKey, secretKey}
+				details = append(details, "secret key generated")
+			}
+			action = models.IamSnapshotChangeActionCreate
+			change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+				return client.addUser(ctx, accessKey, secretKey)
+			})
+		}
+		if status != "" && status != currentStatus {
+			details = append(details, fmt.Sprintf("status: %s", status))
+			change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+				return setUserStatus(ctx, client, accessKey, status)
+			})
+		}
+		// merging a user without policies keeps the ones it has
+		if (replace || len(policies) > 0) && !sameNames(policies, currentPolicyNames) {
+			details = append(details, describePolicyNames(policies))
+			change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+				return setPolicy(ctx, client, strings.Join(policies, ","), accessKey, models.PolicyEntityUser)
+			})
+		}
+		if len(change.steps) == 0 {
+			continue
+		}
+		change.IamSnapshotChange = newChange(models.IamSnapshotChangeEntityUser, accessKey, action, details)
+		changes = append(changes, change)
+	}
+
+	snapshotGroups := map[string]bool{}
+	for _, group := range snapshot.Groups {
+		if err := validateIAMSnapshotStatus("group", group.Name, group.Status); err != nil {
+			return nil, err
+		}
+		for _, policyName := range group.Policies {
+			if !availablePolicies[policyName] {
+				return nil, errors.New(500, "error policy %s of group %s doesn't exist", policyName, group.Name)
+			}
+		}
+		for _, member := range group.Members {
+			if !availableUsers[member] {
+				return nil, errors.New(500, "error member %s of group %s doesn't exist", member, group.Name)
+			}
+		}
+		snapshotGroups[group.Name] = true
+		name, status, policies := group.Name, group.Status, group.Policies
+
+		change := iamSnapshotChange{}
+		action := models.IamSnapshotChangeActionUpdate
+		var details []string
+		currentStatus, currentPolicyNames := string(madmin.GroupEnabled), []string(nil)
+		if currentGroup, exists := currentGroups[name]; exists {
+			currentStatus, currentPolicyNames = currentGroup.Status, currentGroup.Policies
+			membersToAdd := DifferenceArrays(group.Members, currentGroup.Members)
+			if len(membersToAdd) > 0 {
+				details = append(details, "members added: "+strings.Join(membersToAdd, ", "))
+				change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+					return updateGroupMembers(ctx, client, name, membersToAdd, false)
+				})
+			}
+			membersToRemove := DifferenceArrays(currentGroup.Members, group.Members)
+			if replace && len(membersToRemove) > 0 {
+				details = append(details, "members removed: "+strings.Join(membersToRemove, ", "))
+				change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+					return updateGroupMembers(ctx, client, name, membersToRemove, true)
+				})
+			}
+		} else {
+			action = models.IamSnapshotChangeActionCreate
+			members := append([]string{}, group.Members...)
+			if len(members) > 0 {
+				details = append(details, "members added: "+strings.Join(members, ", "))
+			}
+			change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+				return addGroup(ctx, client, name, members)
+			})
+		}
+		if status != "" && status != currentStatus {
+			details = append(details, fmt.Sprintf("status: %s", status))
+			change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+				return setGroupStatus(ctx, client, name, status)
+			})
+		}
+		// merging a group without policies keeps the ones it has
+		if (replace || len(policies) > 0) && !sameNames(policies, currentPolicyNames) {
+			details = append(details, describePolicyNames(policies))
+			change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+				return setPolicy(ctx, client, strings.Join(policies, ","), name, models.PolicyEntityGroup)
+			})
+		}
+		if len(change.steps) == 0 {
+			continue
+		}
+		change.IamSnapshotChange = newChange(models.IamSnapshotChangeEntityGroup, name, action, details)
+		changes = append(changes, change)
+	}
+
+	if !replace {
+		return changes, nil
+	}
+	for _, group := range current.Groups {
+		if snapshotGroups[group.Name] {
+			continue
+		}
+		name, members := group.Name, group.Members
+		change := iamSnapshotChange{IamSnapshotChange: newChange(models.IamSnapshotChangeEntityGroup, name, models.IamSnapshotChangeActionDelete, nil)}
+		// only empty groups can be removed
+		if len(members) > 0 {
+			change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+				return updateGroupMembers(ctx, client, name, members, true)
+			})
+		}
+		change.steps = append(change.steps, func(ctx context.Context, client MinioAdmin) error {
+			return removeGroup(ctx, client, name)
+		})
+		changes = append(changes, change)
+	}
+	for _, user := range current.Users {
+		if availableUsers[user.AccessKey] {
+			continue
+		}
+		accessKey := user.AccessKey
+		changes = append(changes, iamSnapshotChange{
+			IamSnapshotChange: newChange(models.IamSnapshotChangeEntityUser, accessKey, models.IamSnapshotChangeActionDelete, nil),
+			steps: []func(ctx context.Context, client MinioAdmin) error{
+				func(ctx context.Context, client MinioAdmin) error {
+					return removeUser(ctx, client, accessKey)
+				},
+			},
+		})
+	}
+	for _, p := range current.Policies {
+		if availablePolicies[p.Name] {
+			continue
+		}
+		name := p.Name
+		changes = append(changes, iamSnapshotChange{
+			IamSnapshotChange: newChange(models.IamSnapshotChangeEntityPolicy, name, models.IamSnapshotChangeActionDelete, nil),
+			steps: []func(ctx context.Context, client MinioAdmin) error{
+				func(ctx context.Context, client MinioAdmin) error {
+					return removePolicy(ctx, client, name)
+				},
+			},
+		})
+	}
+	return changes, nil
+}
+
+// importIAMSnapshot applies a snapshot to the server returning the changes performed,
+// on dry run the changes are only returned. Changes are applied in order and the import
+// stops at the first failing one, the response tells which ones were applied. Secret keys
+// generated for the users created are kept to be downloaded once like the ones of importUsers().
+func importIAMSnapshot(ctx context.Context, client MinioAdmin, registry *generatedSecretsRegistry, createdBy string, req *models.IamSnapshotImportRequest, now time.Time) (*models.IamSnapshotImportResponse, error) {
+	strategy := req.Strategy
+	switch strategy {
+	case "":
+		strategy = models.IamSnapshotImportRequestStrategyMerge
+	case models.IamSnapshotImportRequestStrategyMerge, models.IamSnapshotImportRequestStrategyReplace:
+	default:
+		return nil, errors.New(500, "error invalid import strategy %s", strategy)
+	}
+	changes, err := planIAMSnapshotImport(ctx, client, req.Snapshot, strategy, req.GenerateSecrets)
+	if err != nil {
+		return nil, err
+	}
+	importResponse := &models.IamSnapshotImportResponse{
+		DryRun:   req.DryRun,
+		Strategy: strategy,
+		Changes:  []*models.IamSnapshotChange{},
+	}
+	for _, change := range changes {
+		change.Status = models.IamSnapshotChangeStatusPending
+		importResponse.Changes = append(importResponse.Changes, change.IamSnapshotChange)
+	}
+	if req.DryRun {
+		return importResponse, nil
+	}
+	secrets := &generatedSecrets{createdBy: createdBy, expiresAt: now.Add(generatedSecretsRetention)}
+	for _, change := range changes {
+		if applied, err := change.apply(ctx, client); err != nil {
+			log.Println("error importing IAM snapshot:", err)
+			change.Status = models.IamSnapshotChangeStatusFailed
+			change.Error = err.Error()
+			// creating the user is the first step, if it succeeded its secret key is still needed
+			if change.credentials != nil && applied > 0 {
+				secrets.credentials = append(secrets.credentials, change.credentials)
+			}
+			break
+		}
+		change.Status = models.IamSnapshotChangeStatusApplied
+		if change.credentials != nil {
+			secrets.credentials = append(secrets.credentials, change.credentials)
+		}
+	}
+	if len(secrets.credentials) > 0 {
+		importResponse.SecretsToken = registry.add(secrets, now)
+		importResponse.SecretsExpiresAt = secrets.expiresAt.UTC().Format(time.RFC3339)
+	}
+	return importResponse, nil
+}
+
+// getExportIAMSnapshotResponse performs exportIAMSnapshot() and serializes it to the handler's output
+func getExportIAMSnapshotResponse(session *models.Principal) (*models.IamSnapshot, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	snapshot, err := exportIAMSnapshot(ctx, adminClient, time.Now())
+	if err != nil {
+		log.Println("error exporting IAM snapshot:", err)
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// getImportIAMSnapshotResponse performs importIAMSnapshot() and serializes it to the handler's output
+func getImportIAMSnapshotResponse(session *models.Principal, params admin_api.ImportIamSnapshotParams) (*models.IamSnapshotImportResponse, error) {
+	// applying a snapshot performs a request per change, so it's given more time than other requests
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+	account, err := getSessionAccountName(session)
+	if err != nil {
+		log.Println("error getting session account:", err)
+		return nil, err
+	}
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	importResponse, err := importIAMSnapshot(ctx, adminClient, globalGeneratedSecrets, account, params.Body, time.Now())
+	if err != nil {
+		log.Println("error importing IAM snapshot:", err)
+		return nil, err
+	}
+	return importResponse, nil
+}
@@ -0,0 +1,265 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportIAMSnapshot(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	function := "exportIAMSnapshot()"
+
+	readonly, _ := iampolicy.ParseConfig(strings.NewReader(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::*"]}]}`))
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readonly": readonly}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"bob":   {Status: madmin.AccountDisabled},
+			"alice": {PolicyName: "readonly,diagnostics", Status: madmin.AccountEnabled},
+		}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"ops", "devs"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Members: []string{"alice"}, Policy: "readonly", Status: "enabled"}, nil
+	}
+
+	// Test-1: exportIAMSnapshot() returns every policy, user and group sorted by name
+	snapshot, err := exportIAMSnapshot(ctx, adminClient, time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(int64(iamSnapshotVersion), snapshot.Version)
+	assert.Equal("2020-08-01T10:00:00Z", snapshot.CreatedAt)
+	if assert.Equal(1, len(snapshot.Policies)) {
+		assert.Equal("readonly", snapshot.Policies[0].Name)
+		assert.Contains(snapshot.Policies[0].Policy, "s3:GetObject")
+	}
+	assert.Equal([]*models.IamSnapshotUser{
+		{AccessKey: "alice", Status: "enabled", Policies: []string{"readonly", "diagnostics"}},
+		{AccessKey: "bob", Status: "disabled", Policies: []string{}},
+	}, snapshot.Users)
+	if assert.Equal(2, len(snapshot.Groups), fmt.Sprintf("Failed on %s: number of groups is not the same", function)) {
+		assert.Equal("devs", snapshot.Groups[0].Name)
+		assert.Equal([]string{"alice"}, snapshot.Groups[0].Members)
+		assert.Equal([]string{"readonly"}, snapshot.Groups[0].Policies)
+	}
+
+	// Test-2: exportIAMSnapshot() handles errors correctly
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return nil, fmt.Errorf("group not found")
+	}
+	if _, err = exportIAMSnapshot(ctx, adminClient, time.Now()); assert.Error(err) {
+		assert.Equal("error getting group devs: group not found", err.Error())
+	}
+}
+
+func TestImportIAMSnapshot(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	function := "importIAMSnapshot()"
+	registry := &generatedSecretsRegistry{}
+	now := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	readonly := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::*"]}]}`
+	writeonly := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::*"]}]}`
+	listonly := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::*"]}]}`
+	parse := func(raw string) *iampolicy.Policy {
+		policy, _ := iampolicy.ParseConfig(strings.NewReader(raw))
+		return policy
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readonly": parse(readonly), "p1": parse(readonly), "old": parse(listonly)}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {PolicyName: "p1", Status: madmin.AccountEnabled},
+			"bob":   {PolicyName: "readonly", Status: madmin.AccountEnabled},
+		}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"devs"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Members: []string{"alice", "bob"}, Policy: "readonly", Status: "enabled"}, nil
+	}
+	var calls []string
+	minioAddPolicyMock = func(name string, policy *iampolicy.Policy) error {
+		calls = append(calls, "addPolicy "+name)
+		return nil
+	}
+	minioRemovePolicyMock = func(name string) error {
+		calls = append(calls, "removePolicy "+name)
+		return nil
+	}
+	minioAddUserMock = func(accessKey, secretKey string) error {
+		calls = append(calls, "addUser "+accessKey+" "+secretKey)
+		return nil
+	}
+	minioRemoveUserMock = func(accessKey string) error {
+		calls = append(calls, "removeUser "+accessKey)
+		return nil
+	}
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		calls = append(calls, fmt.Sprintf("setUserStatus %s %s", accessKey, status))
+		return nil
+	}
+	minioSetGroupStatusMock = func(group string, status madmin.GroupStatus) error {
+		calls = append(calls, fmt.Sprintf("setGroupStatus %s %s", group, status))
+		return nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		calls = append(calls, fmt.Sprintf("setPolicy %s %s %t", policyName, entityName, isGroup))
+		return nil
+	}
+	minioUpdateGroupMembersMock = func(req madmin.GroupAddRemove) error {
+		calls = append(calls, fmt.Sprintf("updateGroupMembers %s %v %t", req.Group, req.Members, req.IsRemove))
+		return nil
+	}
+
+	snapshot := &models.IamSnapshot{
+		Version: iamSnapshotVersion,
+		Policies: []*models.IamSnapshotPolicy{
+			{Name: "readonly", Policy: readonly},
+			{Name: "p1", Policy: writeonly},
+			{Name: "p2", Policy: listonly},
+		},
+		Users: []*models.IamSnapshotUser{
+			{AccessKey: "alice", Status: "disabled", Policies: []string{"p1"}},
+			{AccessKey: "carol", SecretKey: "carol-secret", Policies: []string{"p2"}},
+		},
+		Groups: []*models.IamSnapshotGroup{
+			{Name: "devs", Status: "enabled", Members: []string{"alice", "carol"}, Policies: []string{"p2", "readonly"}},
+			{Name: "ops", Status: "disabled", Members: []string{"carol"}},
+		},
+	}
+
+	// Test-1: importIAMSnapshot() on dry run returns the changes merging the snapshot without applying them
+	importResponse, err := importIAMSnapshot(ctx, adminClient, registry, "admin", &models.IamSnapshotImportRequest{Snapshot: snapshot, DryRun: true}, now)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(models.IamSnapshotImportRequestStrategyMerge, importResponse.Strategy)
+	assert.Equal([]*models.IamSnapshotChange{
+		{Entity: "policy", Name: "p1", Action: "update", Description: "statements changed", Status: "pending"},
+		{Entity: "policy", Name: "p2", Action: "create", Status: "pending"},
+		{Entity: "user", Name: "alice", Action: "update", Description: "status: disabled", Status: "pending"},
+		{Entity: "user", Name: "carol", Action: "create", Description: "policies: p2", Status: "pending"},
+		{Entity: "group", Name: "devs", Action: "update", Description: "members added: carol; policies: p2, readonly", Status: "pending"},
+		{Entity: "group", Name: "ops", Action: "create", Description: "members added: carol; status: disabled", Status: "pending"},
+	}, importResponse.Changes)
+	assert.Nil(calls)
+
+	// Test-2: importIAMSnapshot() replacing the IAM removes what is missing on the snapshot
+	importResponse, err = importIAMSnapshot(ctx, adminClient, registry, "admin", &models.IamSnapshotImportRequest{Snapshot: snapshot, Strategy: "replace"}, now)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(8, len(importResponse.Changes))
+	for _, change := range importResponse.Changes {
+		assert.Equal(models.IamSnapshotChangeStatusApplied, change.Status)
+	}
+	assert.Equal("members added: carol; members removed: bob; policies: p2, readonly", importResponse.Changes[4].Description)
+	assert.Equal([]string{
+		"addPolicy p1",
+		"addPolicy p2",
+		"setUserStatus alice disabled",
+		"addUser carol carol-secret",
+		"setPolicy p2 carol false",
+		"updateGroupMembers devs [carol] false",
+		"updateGroupMembers devs [bob] true",
+		"setPolicy p2,readonly devs true",
+		"updateGroupMembers ops [carol] false",
+		"setGroupStatus ops disabled",
+		"removeUser bob",
+		"removePolicy old",
+	}, calls)
+
+	// Test-3: importIAMSnapshot() stops at the first failing change reporting which ones were applied
+	calls = nil
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		return errors.New("Access Denied.")
+	}
+	importResponse, err = importIAMSnapshot(ctx, adminClient, registry, "admin", &models.IamSnapshotImportRequest{Snapshot: snapshot}, now)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	var statuses []string
+	for _, change := range importResponse.Changes {
+		statuses = append(statuses, change.Status)
+	}
+	assert.Equal([]string{"applied", "applied", "failed", "pending", "pending", "pending"}, statuses)
+	assert.Equal("error applying update of user alice: Access Denied.", importResponse.Changes[2].Error)
+	assert.Equal([]string{"addPolicy p1", "addPolicy p2"}, calls)
+
+	// Test-4: importIAMSnapshot() generates the secret keys of new users and merging keeps the policies of users without any
+	calls = nil
+	newUsers := &models.IamSnapshot{
+		Version: iamSnapshotVersion,
+		Users:   []*models.IamSnapshotUser{{AccessKey: "bob"}, {AccessKey: "erin"}},
+	}
+	importResponse, err = importIAMSnapshot(ctx, adminClient, registry, "admin", &models.IamSnapshotImportRequest{Snapshot: newUsers, GenerateSecrets: true}, now)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]*models.IamSnapshotChange{
+		{Entity: "user", Name: "erin", Action: "create", Description: "secret key generated", Status: "applied"},
+	}, importResponse.Changes)
+	secrets := registry.take(importResponse.SecretsToken, "admin", now)
+	if assert.NotNil(secrets) && assert.Equal(1, len(secrets.credentials)) {
+		assert.Equal("erin", secrets.credentials[0][0])
+		assert.Equal(generatedSecretKeyLength, len(secrets.credentials[0][1]))
+		assert.Equal([]string{"addUser erin " + secrets.credentials[0][1]}, calls)
+	}
+
+	// Test-5: importIAMSnapshot() validates the snapshot before applying any change
+	calls = nil
+	snapshot.Users = append(snapshot.Users, &models.IamSnapshotUser{AccessKey: "dave"})
+	if _, err = importIAMSnapshot(ctx, adminClient, registry, "admin", &models.IamSnapshotImportRequest{Snapshot: snapshot}, now); assert.Error(err) {
+		assert.Equal("error user dave doesn't exist and no secret key was provided", err.Error())
+	}
+	snapshot.Users[2] = &models.IamSnapshotUser{AccessKey: "dave", SecretKey: "dave-secret", Policies: []string{"old"}}
+	if _, err = importIAMSnapshot(ctx, adminClient, registry, "admin", &models.IamSnapshotImportRequest{Snapshot: snapshot, Strategy: "replace"}, now); assert.Error(err) {
+		assert.Equal("error policy old of user dave doesn't exist", err.Error())
+	}
+	snapshot.Version = 2
+	if _, err = importIAMSnapshot(ctx, adminClient, registry, "admin", &models.IamSnapshotImportRequest{Snapshot: snapshot}, now); assert.Error(err) {
+		assert.Equal("error unsupported IAM snapshot version 2", err.Error())
+	}
+	assert.Nil(calls)
+}
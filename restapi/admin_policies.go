@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"strings"
 
 	"github.com/go-openapi/errors"
 
@@ -243,7 +244,51 @@ func setPolicy(ctx context.Context, client MinioAdmin, name, entityName string,
 	return nil
 }
 
-// getSetPolicyResponse() performs setPolicy() and serializes it to the handler's output
+// entityPolicies() returns the policies attached to a group or user
+func entityPolicies(ctx context.Context, client MinioAdmin, entityName string, entityType models.PolicyEntity) ([]string, error) {
+	if entityType == models.PolicyEntityGroup {
+		group, err := client.getGroupDescription(ctx, entityName)
+		if err != nil {
+			return nil, err
+		}
+		return splitPolicyNames(group.Policy), nil
+	}
+	user, err := client.getUserInfo(ctx, entityName)
+	if err != nil {
+		return nil, err
+	}
+	return splitPolicyNames(user.PolicyName), nil
+}
+
+// updatePolicies() attaches policies to a group or user. With the replace mode the given policies become
+// the only ones attached, add and remove keep the rest of the attached policies so layered policies
+// aren't clobbered. MinIO has no API to add or remove a single policy, so add and remove read the
+// attached policies and write the whole list back: two of them running at the same time for the
+// same user or group can overwrite each other and the last one wins.
+func updatePolicies(ctx context.Context, client MinioAdmin, policies []string, entityName string, entityType models.PolicyEntity, mode string) error {
+	policies = UniqueKeys(policies)
+	switch mode {
+	case "", models.SetPolicyRequestModeReplace:
+		return setPolicy(ctx, client, strings.Join(policies, ","), entityName, entityType)
+	case models.SetPolicyRequestModeAdd, models.SetPolicyRequestModeRemove:
+	default:
+		return errors.New(500, "error invalid mode %s", mode)
+	}
+	current, err := entityPolicies(ctx, client, entityName, entityType)
+	if err != nil {
+		return err
+	}
+	updated := append(append([]string{}, current...), DifferenceArrays(policies, current)...)
+	if mode == models.SetPolicyRequestModeRemove {
+		updated = DifferenceArrays(current, policies)
+	}
+	if sameNames(updated, current) {
+		return nil
+	}
+	return setPolicy(ctx, client, strings.Join(updated, ","), entityName, entityType)
+}
+
+// getSetPolicyResponse() performs updatePolicies() and serializes it to the handler's output
 func getSetPolicyResponse(session *models.Principal, name string, params *models.SetPolicyRequest) error {
 	ctx := context.Background()
 	if name == "" {
@@ -259,7 +304,8 @@ func getSetPolicyResponse(session *models.Principal, name string, params *models
 	// defining the client to be used
 	adminClient := adminClient{client: mAdmin}
 
-	if err := setPolicy(ctx, adminClient, name, *params.EntityName, params.EntityType); err != nil {
+	policies := append(splitPolicyNames(name), params.Policies...)
+	if err := updatePolicies(ctx, adminClient, policies, *params.EntityName, params.EntityType, params.Mode); err != nil {
 		log.Println("error setting policy:", err)
 		return err
 	}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/minio/console/models"
 	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -209,3 +210,60 @@ func TestSetPolicy(t *testing.T) {
 		funcAssert.Equal("error", err.Error())
 	}
 }
+
+func TestUpdatePolicies(t *testing.T) {
+	ctx := context.Background()
+	funcAssert := assert.New(t)
+	adminClient := adminClientMock{}
+	function := "updatePolicies()"
+
+	var setPolicyName string
+	var setPolicyCalls int
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		setPolicyName = policyName
+		setPolicyCalls++
+		return nil
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "base-read,team-write"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Policy: "base-read"}, nil
+	}
+
+	// Test-1 : updatePolicies() replaces the policies of the user
+	if err := updatePolicies(ctx, adminClient, []string{"readonly", "diagnostics", "readonly"}, "alevsk", models.PolicyEntityUser, ""); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	funcAssert.Equal("readonly,diagnostics", setPolicyName)
+
+	// Test-2 : updatePolicies() adds policies keeping the ones attached to the group
+	if err := updatePolicies(ctx, adminClient, []string{"team-write", "base-read"}, "devs", models.PolicyEntityGroup, models.SetPolicyRequestModeAdd); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	funcAssert.Equal("base-read,team-write", setPolicyName)
+
+	// Test-3 : updatePolicies() removes policies keeping the rest attached to the user
+	if err := updatePolicies(ctx, adminClient, []string{"team-write"}, "alevsk", models.PolicyEntityUser, models.SetPolicyRequestModeRemove); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	funcAssert.Equal("base-read", setPolicyName)
+
+	// Test-4 : updatePolicies() doesn't update the user if the policies don't change
+	setPolicyCalls = 0
+	if err := updatePolicies(ctx, adminClient, []string{"missing"}, "alevsk", models.PolicyEntityUser, models.SetPolicyRequestModeRemove); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	funcAssert.Equal(0, setPolicyCalls)
+
+	// Test-5 : updatePolicies() rejects unknown modes and handles errors correctly
+	if err := updatePolicies(ctx, adminClient, []string{"readonly"}, "alevsk", models.PolicyEntityUser, "merge"); funcAssert.Error(err) {
+		funcAssert.Equal("error invalid mode merge", err.Error())
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{}, errors.New("error")
+	}
+	if err := updatePolicies(ctx, adminClient, []string{"readonly"}, "alevsk", models.PolicyEntityUser, models.SetPolicyRequestModeAdd); funcAssert.Error(err) {
+		funcAssert.Equal("error", err.Error())
+	}
+}
@@ -0,0 +1,315 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/minio/pkg/bucket/policy"
+	"github.com/minio/minio/pkg/bucket/policy/condition"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// s3ResourceARNPrefix is the prefix of the resources used on IAM policies
+	s3ResourceARNPrefix = "arn:aws:s3:::"
+	// sessionPolicyName identifies the policy of a service account on the simulation results
+	sessionPolicyName = "service account policy"
+)
+
+// namedPolicy is a policy along with the name it's stored with
+type namedPolicy struct {
+	name   string
+	policy *iampolicy.Policy
+}
+
+func registerPolicySimulatorHandlers(api *operations.ConsoleAPI) {
+	// simulate a request against the effective policies of a user, group or service account
+	api.AdminAPISimulatePolicyHandler = admin_api.SimulatePolicyHandlerFunc(func(params admin_api.SimulatePolicyParams, session *models.Principal) middleware.Responder {
+		simulationResponse, err := getSimulatePolicyResponse(session, params)
+		if err != nil {
+			return admin_api.NewSimulatePolicyDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewSimulatePolicyOK().WithPayload(simulationResponse)
+	})
+}
+
+// effectivePolicyNames returns the names of the policies applying to a user, its own policies
+// followed by the policies of the enabled groups it belongs to. If the user or group is disabled
+// the reason is returned instead since every request will be denied.
+func effectivePolicyNames(ctx context.Context, client MinioAdmin, entityType, entityName string) ([]string, string, error) {
+	var names []string
+	switch entityType {
+	case models.PolicySimulationRequestEntityTypeGroup:
+		group, err := client.getGroupDescription(ctx, entityName)
+		if err != nil {
+			return nil, "", err
+		}
+		if group.Status == string(madmin.GroupDisabled) {
+			return nil, "group " + entityName + " is disabled", nil
+		}
+		names = splitPolicyNames(group.Policy)
+	case models.PolicySimulationRequestEntityTypeUser:
+		user, err := client.getUserInfo(ctx, entityName)
+		if err != nil {
+			return nil, "", err
+		}
+		if user.Status == madmin.AccountDisabled {
+			return nil, "user " + entityName + " is disabled", nil
+		}
+		names = splitPolicyNames(user.PolicyName)
+		for _, groupName := range user.MemberOf {
+			group, err := client.getGroupDescription(ctx, groupName)
+			if err != nil {
+				return nil, "", err
+			}
+			if group.Status == string(madmin.GroupDisabled) {
+				continue
+			}
+			names = append(names, splitPolicyNames(group.Policy)...)
+		}
+	default:
+		return nil, "", errors.New(500, "error entity type %s not supported", entityType)
+	}
+	// the same policy can be set on the user and on its groups
+	var unique []string
+	seen := map[string]bool{}
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	return unique, "", nil
+}
+
+// policySimulationArgs builds the arguments of the simulated request, the resource can be an
+// ARN or a bucket/object path. Condition keys are accepted with or without their aws: or s3:
+// prefix, the current time and the user name are set unless given.
+func policySimulationArgs(req *models.PolicySimulationRequest, accountName string, now time.Time) (iampolicy.Args, error) {
+	action := strings.TrimSpace(*req.Action)
+	if !iampolicy.Action(action).IsValid() && !iampolicy.AdminAction(action).IsValid() {
+		return iampolicy.Args{}, errors.New(500, "error action %s not supported", action)
+	}
+	resource := strings.TrimPrefix(strings.TrimSpace(req.Resource), s3ResourceARNPrefix)
+	if strings.HasPrefix(resource, "arn:") {
+		return iampolicy.Args{}, errors.New(500, "error resource must be an S3 ARN")
+	}
+	bucketName, objectName := resource, ""
+	if i := strings.Index(resource, "/"); i >= 0 {
+		bucketName, objectName = resource[:i], resource[i+1:]
+	}
+	conditionValues := map[string][]string{
+		"CurrentTime":   {now.UTC().Format(time.RFC3339)},
+		"EpochTime":     {strconv.FormatInt(now.Unix(), 10)},
+		"principaltype": {"User"},
+		"userid":        {accountName},
+		"username":      {accountName},
+	}
+	for key, value := range req.Conditions {
+		conditionValues[condition.Key(key).Name()] = []string{value}
+	}
+	return iampolicy.Args{
+		AccountName:     accountName,
+		Action:          iampolicy.Action(action),
+		BucketName:      bucketName,
+		ObjectName:      objectName,
+		ConditionValues: conditionValues,
+	}, nil
+}
+
+// matchingStatements returns the statements of the policies with the given effect that apply to
+// the request, a deny statement applies when its IsAllowed() is false
+func matchingStatements(policies []namedPolicy, args iampolicy.Args, effect policy.Effect) ([]*models.PolicyStatementMatch, error) {
+	var matches []*models.PolicyStatementMatch
+	for _, p := range policies {
+		for i, statement := range p.policy.Statements {
+			if statement.Effect != effect || statement.IsAllowed(args) != (effect == policy.Allow) {
+				continue
+			}
+			rawStatement, err := json.Marshal(statement)
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, &models.PolicyStatementMatch{
+				Policy:    p.name,
+				Index:     int64(i),
+				Effect:    string(statement.Effect),
+				Statement: string(rawStatement),
+			})
+		}
+	}
+	return matches, nil
+}
+
+// evaluatePolicies merges the policies the same way MinIO does and evaluates the request with
+// iampolicy.Policy.IsAllowed, the statements that explain the result are returned along with it.
+func evaluatePolicies(policies []namedPolicy, args iampolicy.Args) (bool, []*models.PolicyStatementMatch, error) {
+	merged := iampolicy.Policy{Version: iampolicy.DefaultVersion}
+	for _, p := range policies {
+		merged.Statements = append(merged.Statements, p.policy.Statements...)
+	}
+	if merged.IsAllowed(args) {
+		matches, err := matchingStatements(policies, args, policy.Allow)
+		return true, matches, err
+	}
+	matches, err := matchingStatements(policies, args, policy.Deny)
+	return false, matches, err
+}
+
+// checkServiceAccount makes sure the service account exists and belongs to parentUser. MinIO only
+// lists the service accounts of the caller so the parent must be the logged in account.
+func checkServiceAccount(ctx context.Context, client MinioAdmin, serviceAccount, parentUser, accountName string) error {
+	if parentUser == "" {
+		return errors.New(500, "error parentUser is required to simulate a service account")
+	}
+	if parentUser != accountName {
+		return errors.New(500, "error only service accounts of %s can be simulated", accountName)
+	}
+	serviceAccounts, err := client.listServiceAccounts(ctx)
+	if err != nil {
+		return err
+	}
+	if !IsElementInArray(serviceAccounts.Accounts, serviceAccount) {
+		return errors.New(500, "error service account %s doesn't belong to %s", serviceAccount, parentUser)
+	}
+	return nil
+}
+
+// simulatePolicy evaluates a request against the effective policies of a user, group or service
+// account. Service accounts are evaluated with the policies of their parent user and, if given,
+// the policy they were created with, both must allow the request.
+func simulatePolicy(ctx context.Context, client MinioAdmin, req *models.PolicySimulationRequest, accountName string, now time.Time) (*models.PolicySimulationResponse, error) {
+	entityType, entityName := *req.EntityType, *req.EntityName
+	var sessionPolicy *iampolicy.Policy
+	if entityType == models.PolicySimulationRequestEntityTypeServiceAccount {
+		if err := checkServiceAccount(ctx, client, entityName, req.ParentUser, accountName); err != nil {
+			return nil, err
+		}
+		if req.SessionPolicy != "" {
+			var err error
+			if sessionPolicy, err = iampolicy.ParseConfig(strings.NewReader(req.SessionPolicy)); err != nil {
+				return nil, errors.New(500, "error invalid service account policy: %v", err)
+			}
+		}
+		entityType, entityName = models.PolicySimulationRequestEntityTypeUser, req.ParentUser
+	}
+	args, err := policySimulationArgs(req, entityName, now)
+	if err != nil {
+		return nil, err
+	}
+	names, disabledReason, err := effectivePolicyNames(ctx, client, entityType, entityName)
+	if err != nil {
+		return nil, err
+	}
+	response := &models.PolicySimulationResponse{
+		Decision:          models.PolicySimulationResponseDecisionDeny,
+		Policies:          []string{},
+		MatchedStatements: []*models.PolicyStatementMatch{},
+	}
+	if disabledReason != "" {
+		response.Reason = disabledReason
+		return response, nil
+	}
+	var policies []namedPolicy
+	for _, name := range names {
+		p, err := client.getPolicy(ctx, name)
+		if err != nil {
+			return nil, errors.New(500, "error getting policy %s: %v", name, err)
+		}
+		policies = append(policies, namedPolicy{name: name, policy: p})
+		response.Policies = append(response.Policies, name)
+	}
+	if len(policies) == 0 {
+		response.Reason = "no policy is set for " + entityName
+		return response, nil
+	}
+
+	allowed, matches, err := evaluatePolicies(policies, args)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !allowed && len(matches) > 0:
+		response.Reason = "explicitly denied"
+	case !allowed:
+		response.Reason = "no statement allows the action"
+	case sessionPolicy != nil:
+		response.Policies = append(response.Policies, sessionPolicyName)
+		sessionPolicies := []namedPolicy{{name: sessionPolicyName, policy: sessionPolicy}}
+		sessionAllowed, sessionMatches, err := evaluatePolicies(sessionPolicies, args)
+		if err != nil {
+			return nil, err
+		}
+		if !sessionAllowed {
+			response.Reason = "allowed to the parent user but not by the service account policy"
+			matches = sessionMatches
+			break
+		}
+		response.Decision = models.PolicySimulationResponseDecisionAllow
+		response.Reason = "allowed"
+		matches = append(matches, sessionMatches...)
+	default:
+		response.Decision = models.PolicySimulationResponseDecisionAllow
+		response.Reason = "allowed"
+	}
+	if matches != nil {
+		response.MatchedStatements = matches
+	}
+	return response, nil
+}
+
+// getSimulatePolicyResponse performs simulatePolicy() and serializes it to the handler's output
+func getSimulatePolicyResponse(session *models.Principal, params admin_api.SimulatePolicyParams) (*models.PolicySimulationResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	// service accounts can only be looked up for the logged in account
+	var accountName string
+	if params.Body.EntityType != nil && *params.Body.EntityType == models.PolicySimulationRequestEntityTypeServiceAccount {
+		if accountName, err = getSessionAccountName(session); err != nil {
+			log.Println("error getting session account:", err)
+			return nil, err
+		}
+	}
+	simulationResponse, err := simulatePolicy(ctx, adminClient, params.Body, accountName, time.Now())
+	if err != nil {
+		log.Println("error simulating policy:", err)
+		return nil, err
+	}
+	return simulationResponse, nil
+}
@@ -0,0 +1,184 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatePolicy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := adminClientMock{}
+	function := "simulatePolicy()"
+	now := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	policies := map[string]string{
+		"readwrite":   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`,
+		"no-secrets":  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/secrets/*"]}]}`,
+		"office-only": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::reports/*"],"Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`,
+	}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		raw, ok := policies[name]
+		if !ok {
+			return nil, errors.New("The canned policy does not exist")
+		}
+		return iampolicy.ParseConfig(strings.NewReader(raw))
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		switch accessKey {
+		case "disabled":
+			return madmin.UserInfo{Status: madmin.AccountDisabled, PolicyName: "readwrite"}, nil
+		case "analyst":
+			return madmin.UserInfo{Status: madmin.AccountEnabled, PolicyName: "office-only", MemberOf: []string{"off"}}, nil
+		}
+		return madmin.UserInfo{Status: madmin.AccountEnabled, PolicyName: "readwrite", MemberOf: []string{"restricted", "off"}}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "off" {
+			return &madmin.GroupDesc{Name: group, Status: "disabled", Policy: "readwrite"}, nil
+		}
+		return &madmin.GroupDesc{Name: group, Status: "enabled", Policy: "no-secrets,readwrite"}, nil
+	}
+	request := func(entityType, entityName, action, resource string) *models.PolicySimulationRequest {
+		return &models.PolicySimulationRequest{
+			EntityType: swag.String(entityType),
+			EntityName: swag.String(entityName),
+			Action:     swag.String(action),
+			Resource:   resource,
+		}
+	}
+
+	// Test-1: simulatePolicy() allows actions allowed by the user policies
+	req := request(models.PolicySimulationRequestEntityTypeUser, "alice", "s3:PutObject", "arn:aws:s3:::data/secrets/key.pem")
+	simulation, err := simulatePolicy(ctx, adminClient, req, "alice", now)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(models.PolicySimulationResponseDecisionAllow, simulation.Decision)
+	assert.Equal([]string{"readwrite", "no-secrets"}, simulation.Policies)
+	if assert.Equal(1, len(simulation.MatchedStatements)) {
+		assert.Equal("readwrite", simulation.MatchedStatements[0].Policy)
+		assert.Equal("Allow", simulation.MatchedStatements[0].Effect)
+	}
+
+	// Test-2: simulatePolicy() reports the statements of the groups denying the action
+	req = request(models.PolicySimulationRequestEntityTypeUser, "alice", "s3:GetObject", "data/secrets/key.pem")
+	simulation, err = simulatePolicy(ctx, adminClient, req, "alice", now)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(models.PolicySimulationResponseDecisionDeny, simulation.Decision)
+	assert.Equal("explicitly denied", simulation.Reason)
+	if assert.Equal(1, len(simulation.MatchedStatements)) {
+		assert.Equal(&models.PolicyStatementMatch{Policy: "no-secrets", Index: 0, Effect: "Deny", Statement: `{"Effect":"Deny","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/secrets/*"]}`}, simulation.MatchedStatements[0])
+	}
+
+	// Test-3: simulatePolicy() evaluates conditions, disabled groups are ignored
+	req = request(models.PolicySimulationRequestEntityTypeUser, "analyst", "s3:GetObject", "reports/q1.pdf")
+	req.Conditions = map[string]string{"aws:SourceIp": "192.168.1.1"}
+	simulation, _ = simulatePolicy(ctx, adminClient, req, "alice", now)
+	assert.Equal([]string{"office-only"}, simulation.Policies)
+	assert.Equal(models.PolicySimulationResponseDecisionDeny, simulation.Decision)
+	assert.Equal("no statement allows the action", simulation.Reason)
+	assert.Equal(0, len(simulation.MatchedStatements))
+	req.Conditions = map[string]string{"SourceIp": "10.1.2.3"}
+	simulation, _ = simulatePolicy(ctx, adminClient, req, "alice", now)
+	assert.Equal(models.PolicySimulationResponseDecisionAllow, simulation.Decision)
+
+	// Test-4: simulatePolicy() denies everything to disabled users
+	req = request(models.PolicySimulationRequestEntityTypeUser, "disabled", "s3:GetObject", "data/a.txt")
+	simulation, _ = simulatePolicy(ctx, adminClient, req, "alice", now)
+	assert.Equal(models.PolicySimulationResponseDecisionDeny, simulation.Decision)
+	assert.Equal("user disabled is disabled", simulation.Reason)
+
+	// Test-5: simulatePolicy() requires both the parent user and the service account policy to allow
+	minioListServiceAccountsMock = func(ctx context.Context) (madmin.ListServiceAccountsResp, error) {
+		return madmin.ListServiceAccountsResp{Accounts: []string{"svc1"}}, nil
+	}
+	req = request(models.PolicySimulationRequestEntityTypeServiceAccount, "svc1", "s3:PutObject", "data/a.txt")
+	_, err = simulatePolicy(ctx, adminClient, req, "alice", now)
+	assert.Error(err)
+	req.ParentUser = "alice"
+	req.SessionPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`
+	simulation, _ = simulatePolicy(ctx, adminClient, req, "alice", now)
+	assert.Equal(models.PolicySimulationResponseDecisionDeny, simulation.Decision)
+	assert.Equal("allowed to the parent user but not by the service account policy", simulation.Reason)
+	assert.Equal([]string{"readwrite", "no-secrets", sessionPolicyName}, simulation.Policies)
+	req.Action = swag.String("s3:GetObject")
+	simulation, _ = simulatePolicy(ctx, adminClient, req, "alice", now)
+	assert.Equal(models.PolicySimulationResponseDecisionAllow, simulation.Decision)
+	assert.Equal(2, len(simulation.MatchedStatements))
+
+	// Test-6: simulatePolicy() rejects service accounts missing or owned by other users
+	req = request(models.PolicySimulationRequestEntityTypeServiceAccount, "svc2", "s3:GetObject", "data/a.txt")
+	req.ParentUser = "alice"
+	if _, err = simulatePolicy(ctx, adminClient, req, "alice", now); assert.Error(err) {
+		assert.Equal("error service account svc2 doesn't belong to alice", err.Error())
+	}
+	req = request(models.PolicySimulationRequestEntityTypeServiceAccount, "svc1", "s3:GetObject", "data/a.txt")
+	req.ParentUser = "bob"
+	if _, err = simulatePolicy(ctx, adminClient, req, "alice", now); assert.Error(err) {
+		assert.Equal("error only service accounts of alice can be simulated", err.Error())
+	}
+
+	// Test-7: simulatePolicy() rejects unknown actions and handles errors correctly
+	_, err = simulatePolicy(ctx, adminClient, request(models.PolicySimulationRequestEntityTypeGroup, "restricted", "s3:Fly", ""), "alice", now)
+	if assert.Error(err) {
+		assert.Equal("error action s3:Fly not supported", err.Error())
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Status: "enabled", Policy: "deleted"}, nil
+	}
+	_, err = simulatePolicy(ctx, adminClient, request(models.PolicySimulationRequestEntityTypeGroup, "restricted", "s3:GetObject", "data/a.txt"), "alice", now)
+	if assert.Error(err) {
+		assert.Equal("error getting policy deleted: The canned policy does not exist", err.Error())
+	}
+}
+
+func TestPolicySimulationArgs(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	// Test-1: policySimulationArgs() splits the resource and sets the default conditions
+	req := &models.PolicySimulationRequest{Action: swag.String("s3:GetObject"), Resource: "arn:aws:s3:::bucket1/photos/a.jpg", Conditions: map[string]string{"aws:Referer": "console"}}
+	args, err := policySimulationArgs(req, "alice", now)
+	assert.Nil(err)
+	assert.Equal("bucket1", args.BucketName)
+	assert.Equal("photos/a.jpg", args.ObjectName)
+	assert.Equal([]string{"console"}, args.ConditionValues["Referer"])
+	assert.Equal([]string{"alice"}, args.ConditionValues["username"])
+	assert.Equal([]string{"2020-08-01T10:00:00Z"}, args.ConditionValues["CurrentTime"])
+
+	// Test-2: policySimulationArgs() accepts admin actions and rejects other ARNs
+	_, err = policySimulationArgs(&models.PolicySimulationRequest{Action: swag.String("admin:ServerInfo")}, "alice", now)
+	assert.Nil(err)
+	_, err = policySimulationArgs(&models.PolicySimulationRequest{Action: swag.String("s3:GetObject"), Resource: "arn:minio:sqs::1:webhook"}, "alice", now)
+	assert.Error(err)
+}
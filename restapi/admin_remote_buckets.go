@@ -0,0 +1,180 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	mauth "github.com/minio/minio/pkg/auth"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func registerAdminRemoteBucketsHandlers(api *operations.ConsoleAPI) {
+	// list remote buckets
+	api.AdminAPIListRemoteBucketsHandler = admin_api.ListRemoteBucketsHandlerFunc(func(params admin_api.ListRemoteBucketsParams, session *models.Principal) middleware.Responder {
+		remoteBucketsResp, err := getListRemoteBucketsResponse(session, params)
+		if err != nil {
+			return admin_api.NewListRemoteBucketsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewListRemoteBucketsOK().WithPayload(remoteBucketsResp)
+	})
+	// add remote bucket
+	api.AdminAPIAddRemoteBucketHandler = admin_api.AddRemoteBucketHandlerFunc(func(params admin_api.AddRemoteBucketParams, session *models.Principal) middleware.Responder {
+		remoteBucket, err := getAddRemoteBucketResponse(session, params)
+		if err != nil {
+			return admin_api.NewAddRemoteBucketDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewAddRemoteBucketCreated().WithPayload(remoteBucket)
+	})
+	// delete remote bucket
+	api.AdminAPIDeleteRemoteBucketHandler = admin_api.DeleteRemoteBucketHandlerFunc(func(params admin_api.DeleteRemoteBucketParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteRemoteBucketResponse(session, params); err != nil {
+			return admin_api.NewDeleteRemoteBucketDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewDeleteRemoteBucketNoContent()
+	})
+}
+
+// remoteBucketFromTarget serializes a bucket target, its secret key is never returned
+func remoteBucketFromTarget(target madmin.BucketTarget) *models.RemoteBucket {
+	remoteBucket := &models.RemoteBucket{
+		Arn:          target.Arn,
+		SourceBucket: target.SourceBucket,
+		TargetBucket: target.TargetBucket,
+		Endpoint:     target.Endpoint,
+		Secure:       target.Secure,
+		Region:       target.Region,
+		Service:      string(target.Type),
+	}
+	if target.Credentials != nil {
+		remoteBucket.AccessKey = target.Credentials.AccessKey
+	}
+	return remoteBucket
+}
+
+// listRemoteBuckets returns the replication targets of a bucket, or of every bucket if no bucket is given
+func listRemoteBuckets(ctx context.Context, client MinioAdmin, bucketName string) (*models.ListRemoteBucketsResponse, error) {
+	targets, err := client.listRemoteTargets(ctx, bucketName, string(madmin.ReplicationService))
+	if err != nil {
+		return nil, err
+	}
+	remoteBuckets := []*models.RemoteBucket{}
+	for _, target := range targets {
+		remoteBuckets = append(remoteBuckets, remoteBucketFromTarget(target))
+	}
+	return &models.ListRemoteBucketsResponse{
+		Buckets: remoteBuckets,
+		Total:   int64(len(remoteBuckets)),
+	}, nil
+}
+
+// addRemoteBucket registers a remote bucket as replication target of a local bucket,
+// MinIO validates the target and returns the ARN to be used on replication rules.
+func addRemoteBucket(ctx context.Context, client MinioAdmin, req *models.CreateRemoteBucket) (*models.RemoteBucket, error) {
+	target := madmin.BucketTarget{
+		SourceBucket: *req.SourceBucket,
+		TargetBucket: *req.TargetBucket,
+		Endpoint:     *req.Endpoint,
+		Secure:       req.Secure,
+		Region:       req.Region,
+		Credentials: &mauth.Credentials{
+			AccessKey: *req.AccessKey,
+			SecretKey: *req.SecretKey,
+		},
+		API:  "s3v4",
+		Type: madmin.ReplicationService,
+	}
+	arn, err := client.setRemoteTarget(ctx, *req.SourceBucket, &target)
+	if err != nil {
+		return nil, err
+	}
+	target.Arn = arn
+	return remoteBucketFromTarget(target), nil
+}
+
+// getListRemoteBucketsResponse performs listRemoteBuckets() and serializes it to the handler's output
+func getListRemoteBucketsResponse(session *models.Principal, params admin_api.ListRemoteBucketsParams) (*models.ListRemoteBucketsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	bucketName := ""
+	if params.BucketName != nil {
+		bucketName = *params.BucketName
+	}
+	remoteBuckets, err := listRemoteBuckets(ctx, adminClient, bucketName)
+	if err != nil {
+		log.Println("error listing remote buckets:", err)
+		return nil, err
+	}
+	return remoteBuckets, nil
+}
+
+// getAddRemoteBucketResponse performs addRemoteBucket() and serializes it to the handler's output
+func getAddRemoteBucketResponse(session *models.Principal, params admin_api.AddRemoteBucketParams) (*models.RemoteBucket, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	remoteBucket, err := addRemoteBucket(ctx, adminClient, params.Body)
+	if err != nil {
+		log.Println("error adding remote bucket:", err)
+		return nil, err
+	}
+	return remoteBucket, nil
+}
+
+// getDeleteRemoteBucketResponse removes a remote bucket target
+func getDeleteRemoteBucketResponse(session *models.Principal, params admin_api.DeleteRemoteBucketParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	if err := adminClient.removeRemoteTarget(ctx, params.BucketName, params.Arn); err != nil {
+		log.Println("error removing remote bucket:", err)
+		return err
+	}
+	return nil
+}
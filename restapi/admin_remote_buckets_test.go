@@ -0,0 +1,135 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	mauth "github.com/minio/minio/pkg/auth"
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioListRemoteTargetsMock func(ctx context.Context, bucketName, arnType string) ([]madmin.BucketTarget, error)
+var minioSetRemoteTargetMock func(ctx context.Context, bucketName string, target *madmin.BucketTarget) (string, error)
+var minioRemoveRemoteTargetMock func(ctx context.Context, bucketName, arn string) error
+
+// mock function of listRemoteTargets()
+func (ac adminClientMock) listRemoteTargets(ctx context.Context, bucketName, arnType string) ([]madmin.BucketTarget, error) {
+	return minioListRemoteTargetsMock(ctx, bucketName, arnType)
+}
+
+// mock function of setRemoteTarget()
+func (ac adminClientMock) setRemoteTarget(ctx context.Context, bucketName string, target *madmin.BucketTarget) (string, error) {
+	return minioSetRemoteTargetMock(ctx, bucketName, target)
+}
+
+// mock function of removeRemoteTarget()
+func (ac adminClientMock) removeRemoteTarget(ctx context.Context, bucketName, arn string) error {
+	return minioRemoveRemoteTargetMock(ctx, bucketName, arn)
+}
+
+func TestListRemoteBuckets(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	function := "listRemoteBuckets()"
+
+	// Test-1: listRemoteBuckets() returns the replication targets without secrets
+	var gotType string
+	minioListRemoteTargetsMock = func(ctx context.Context, bucketName, arnType string) ([]madmin.BucketTarget, error) {
+		gotType = arnType
+		return []madmin.BucketTarget{
+			{
+				SourceBucket: "source",
+				TargetBucket: "target",
+				Endpoint:     "play.min.io",
+				Secure:       true,
+				Arn:          "arn:minio:replication::1234:target",
+				Type:         madmin.ReplicationService,
+				Credentials:  &mauth.Credentials{AccessKey: "access", SecretKey: "secret"},
+			},
+		}, nil
+	}
+	remoteBuckets, err := listRemoteBuckets(ctx, adminClient, "source")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("replication", gotType)
+	if assert.Equal(int64(1), remoteBuckets.Total) {
+		assert.Equal(&models.RemoteBucket{
+			Arn:          "arn:minio:replication::1234:target",
+			SourceBucket: "source",
+			TargetBucket: "target",
+			Endpoint:     "play.min.io",
+			Secure:       true,
+			AccessKey:    "access",
+			Service:      "replication",
+		}, remoteBuckets.Buckets[0])
+	}
+
+	// Test-2: listRemoteBuckets() handles errors correctly
+	minioListRemoteTargetsMock = func(ctx context.Context, bucketName, arnType string) ([]madmin.BucketTarget, error) {
+		return nil, errors.New("error")
+	}
+	if _, err = listRemoteBuckets(ctx, adminClient, ""); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestAddRemoteBucket(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	function := "addRemoteBucket()"
+
+	// Test-1: addRemoteBucket() registers a replication target and returns its ARN
+	var gotTarget *madmin.BucketTarget
+	minioSetRemoteTargetMock = func(ctx context.Context, bucketName string, target *madmin.BucketTarget) (string, error) {
+		gotTarget = target
+		return "arn:minio:replication::1234:target", nil
+	}
+	req := &models.CreateRemoteBucket{
+		SourceBucket: swag.String("source"),
+		TargetBucket: swag.String("target"),
+		Endpoint:     swag.String("play.min.io"),
+		AccessKey:    swag.String("access"),
+		SecretKey:    swag.String("secret"),
+		Secure:       true,
+	}
+	remoteBucket, err := addRemoteBucket(ctx, adminClient, req)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("arn:minio:replication::1234:target", remoteBucket.Arn)
+	assert.Equal(madmin.ReplicationService, gotTarget.Type)
+	assert.Equal("secret", gotTarget.Credentials.SecretKey)
+
+	// Test-2: addRemoteBucket() handles errors correctly
+	minioSetRemoteTargetMock = func(ctx context.Context, bucketName string, target *madmin.BucketTarget) (string, error) {
+		return "", errors.New("Remote service endpoint or target bucket not available")
+	}
+	if _, err = addRemoteBucket(ctx, adminClient, req); assert.Error(err) {
+		assert.Equal("Remote service endpoint or target bucket not available", err.Error())
+	}
+}
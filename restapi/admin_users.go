@@ -23,12 +23,15 @@ import (
 	"github.com/minio/console/models"
 	"github.com/minio/console/restapi/operations"
 	"github.com/minio/console/restapi/operations/admin_api"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
 	"github.com/minio/minio/pkg/madmin"
 
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 )
 
 func registerUsersHandlers(api *operations.ConsoleAPI) {
@@ -74,6 +77,14 @@ func registerUsersHandlers(api *operations.ConsoleAPI) {
 
 		return admin_api.NewGetUserInfoOK().WithPayload(userInfoResponse)
 	})
+	// Get User Effective Policy
+	api.AdminAPIGetUserEffectivePolicyHandler = admin_api.GetUserEffectivePolicyHandlerFunc(func(params admin_api.GetUserEffectivePolicyParams, session *models.Principal) middleware.Responder {
+		effectivePolicy, err := getUserEffectivePolicyResponse(session, params)
+		if err != nil {
+			return admin_api.NewGetUserEffectivePolicyDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewGetUserEffectivePolicyOK().WithPayload(effectivePolicy)
+	})
 	// Update User
 	api.AdminAPIUpdateUserInfoHandler = admin_api.UpdateUserInfoHandlerFunc(func(params admin_api.UpdateUserInfoParams, session *models.Principal) middleware.Responder {
 		userUpdateResponse, err := getUpdateUserResponse(session, params)
@@ -109,7 +120,7 @@ func listUsers(ctx context.Context, client MinioAdmin) ([]*models.User, error) {
 		userElem := &models.User{
 			AccessKey: accessKey,
 			Status:    string(user.Status),
-			Policy:    user.PolicyName,
+			Policy:    splitPolicyNames(user.PolicyName),
 			MemberOf:  user.MemberOf,
 		}
 		users = append(users, userElem)
@@ -161,7 +172,7 @@ func addUser(ctx context.Context, client MinioAdmin, accessKey, secretKey *strin
 	userRet := &models.User{
 		AccessKey: *accessKey,
 		MemberOf:  nil,
-		Policy:    "",
+		Policy:    nil,
 		Status:    "",
 	}
 	return userRet, nil
@@ -226,6 +237,119 @@ func getUserInfo(ctx context.Context, client MinioAdmin, accessKey string) (*mad
 	return &userInfo, nil
 }
 
+// sameStatement compares two policy statements ignoring the order of their actions, resources and conditions
+func sameStatement(a, b iampolicy.Statement) bool {
+	return a.Effect == b.Effect &&
+		a.Actions.Equals(b.Actions) &&
+		a.Resources.Equals(b.Resources) &&
+		a.Conditions.String() == b.Conditions.String()
+}
+
+// getUserEffectivePolicy merges the policies assigned to the user with the ones of the groups it belongs to,
+// every statement of the merged policy keeps the policies and groups it comes from.
+// Policies of disabled groups are listed on the group but they are not applied to the user.
+func getUserEffectivePolicy(ctx context.Context, client MinioAdmin, accessKey string) (*models.UserEffectivePolicy, error) {
+	user, err := getUserInfo(ctx, client, accessKey)
+	if err != nil {
+		return nil, err
+	}
+	effectivePolicy := &models.UserEffectivePolicy{
+		AccessKey:  accessKey,
+		Status:     string(user.Status),
+		Policies:   []string{},
+		Statements: []*models.EffectivePolicyStatement{},
+		Groups:     []*models.EffectivePolicyGroup{},
+	}
+
+	var sources []*models.PolicySource
+	for _, policyName := range splitPolicyNames(user.PolicyName) {
+		sources = append(sources, &models.PolicySource{Policy: policyName})
+	}
+	for _, groupName := range user.MemberOf {
+		group, err := client.getGroupDescription(ctx, groupName)
+		if err != nil {
+			return nil, errors.New(500, "error getting group %s: %v", groupName, err)
+		}
+		groupPolicies := splitPolicyNames(group.Policy)
+		applied := group.Status != string(madmin.GroupDisabled)
+		effectivePolicy.Groups = append(effectivePolicy.Groups, &models.EffectivePolicyGroup{
+			Name:     groupName,
+			Status:   group.Status,
+			Policies: append([]string{}, groupPolicies...),
+			Applied:  applied,
+		})
+		if !applied {
+			continue
+		}
+		for _, policyName := range groupPolicies {
+			sources = append(sources, &models.PolicySource{Policy: policyName, Group: groupName})
+		}
+	}
+
+	// the same policy may be assigned to the user and to several groups, it is fetched once
+	// and its statements list every source it was assigned from
+	policies := map[string]*iampolicy.Policy{}
+	merged := iampolicy.Policy{Version: iampolicy.DefaultVersion}
+	for _, source := range sources {
+		policy, ok := policies[source.Policy]
+		if !ok {
+			policy, err = client.getPolicy(ctx, source.Policy)
+			if err != nil {
+				return nil, errors.New(500, "error getting policy %s: %v", source.Policy, err)
+			}
+			policies[source.Policy] = policy
+			effectivePolicy.Policies = append(effectivePolicy.Policies, source.Policy)
+		}
+	statements:
+		for _, statement := range policy.Statements {
+			for i, mergedStatement := range merged.Statements {
+				if sameStatement(statement, mergedStatement) {
+					effectivePolicy.Statements[i].Sources = append(effectivePolicy.Statements[i].Sources, source)
+					continue statements
+				}
+			}
+			rawStatement, err := json.Marshal(statement)
+			if err != nil {
+				return nil, err
+			}
+			merged.Statements = append(merged.Statements, statement)
+			effectivePolicy.Statements = append(effectivePolicy.Statements, &models.EffectivePolicyStatement{
+				Effect:    string(statement.Effect),
+				Statement: string(rawStatement),
+				Sources:   []*models.PolicySource{source},
+			})
+		}
+	}
+
+	rawPolicy, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	effectivePolicy.Policy = string(rawPolicy)
+	return effectivePolicy, nil
+}
+
+// getUserEffectivePolicyResponse performs getUserEffectivePolicy() and serializes it to the handler's output
+func getUserEffectivePolicyResponse(session *models.Principal, params admin_api.GetUserEffectivePolicyParams) (*models.UserEffectivePolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	effectivePolicy, err := getUserEffectivePolicy(ctx, adminClient, params.Name)
+	if err != nil {
+		log.Println("error getting user effective policy:", err)
+		return nil, err
+	}
+	return effectivePolicy, nil
+}
+
 func getUserInfoResponse(session *models.Principal, params admin_api.GetUserInfoParams) (*models.User, error) {
 	ctx := context.Background()
 
@@ -248,7 +372,7 @@ func getUserInfoResponse(session *models.Principal, params admin_api.GetUserInfo
 	userInformation := &models.User{
 		AccessKey: params.Name,
 		MemberOf:  user.MemberOf,
-		Policy:    user.PolicyName,
+		Policy:    splitPolicyNames(user.PolicyName),
 		Status:    string(user.Status),
 	}
 
@@ -334,7 +458,7 @@ func updateUserGroups(ctx context.Context, client MinioAdmin, user string, group
 	userReturn := &models.User{
 		AccessKey: user,
 		MemberOf:  userInfo.MemberOf,
-		Policy:    userInfo.PolicyName,
+		Policy:    splitPolicyNames(userInfo.PolicyName),
 		Status:    string(userInfo.Status),
 	}
 
@@ -0,0 +1,501 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// maxImportUsersRows limits the users created by a single import
+	maxImportUsersRows = 5000
+	// defaultImportUsersConcurrency is the number of users created at the same time if not requested
+	defaultImportUsersConcurrency = 4
+	// maxImportUsersConcurrency limits the requested concurrency
+	maxImportUsersConcurrency = 16
+	// minAccessKeyLength and minSecretKeyLength are the lengths required by MinIO
+	minAccessKeyLength = 3
+	minSecretKeyLength = 8
+	// generatedSecretKeyLength is the length of the secret keys generated for imported users
+	generatedSecretKeyLength = 40
+	// generatedSecretsRetention is how long generated secret keys can be downloaded
+	generatedSecretsRetention = 15 * time.Minute
+)
+
+// importUsersColumns are the columns accepted on the CSV header
+var importUsersColumns = []string{"accessKey", "secretKey", "generateSecret", "groups", "policy", "status"}
+
+// importUserRow is a user read from the import CSV along with the problems found on it
+type importUserRow struct {
+	line           int64
+	accessKey      string
+	secretKey      string
+	generateSecret bool
+	groups         []string
+	policies       []string
+	status         string
+	errors         []string
+}
+
+// generatedSecrets are the secret keys generated by an import, they can be downloaded only once
+type generatedSecrets struct {
+	createdBy string
+	expiresAt time.Time
+	// pairs of access and secret keys
+	credentials [][]string
+}
+
+// generatedSecretsRegistry keeps in memory the secret keys generated by imports until they are downloaded
+type generatedSecretsRegistry struct {
+	sync.Mutex
+	secrets map[string]*generatedSecrets
+}
+
+// globalGeneratedSecrets records the secret keys generated by ImportUsers
+var globalGeneratedSecrets = &generatedSecretsRegistry{}
+
+// add records the secrets returning the token to download them, expired secrets are dropped
+func (r *generatedSecretsRegistry) add(secrets *generatedSecrets, now time.Time) string {
+	r.Lock()
+	defer r.Unlock()
+	if r.secrets == nil {
+		r.secrets = make(map[string]*generatedSecrets)
+	}
+	for token, existing := range r.secrets {
+		if now.After(existing.expiresAt) {
+			delete(r.secrets, token)
+		}
+	}
+	token := RandomCharString(32)
+	r.secrets[token] = secrets
+	return token
+}
+
+// take returns the secrets only to the user that generated them and forgets them
+func (r *generatedSecretsRegistry) take(token, createdBy string, now time.Time) *generatedSecrets {
+	r.Lock()
+	defer r.Unlock()
+	secrets, ok := r.secrets[token]
+	if !ok || secrets.createdBy != createdBy {
+		return nil
+	}
+	delete(r.secrets, token)
+	if now.After(secrets.expiresAt) {
+		return nil
+	}
+	return secrets
+}
+
+func registerUsersImportHandlers(api *operations.ConsoleAPI) {
+	// Import users from CSV
+	api.AdminAPIImportUsersHandler = admin_api.ImportUsersHandlerFunc(func(params admin_api.ImportUsersParams, session *models.Principal) middleware.Responder {
+		importResponse, err := getImportUsersResponse(session, params)
+		if err != nil {
+			return admin_api.NewImportUsersDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewImportUsersOK().WithPayload(importResponse)
+	})
+	// Download the secret keys generated by an import
+	api.AdminAPIDownloadImportedUsersSecretsHandler = admin_api.DownloadImportedUsersSecretsHandlerFunc(func(params admin_api.DownloadImportedUsersSecretsParams, session *models.Principal) middleware.Responder {
+		// secrets belong to the account, session keys change on every login
+		account, err := getSessionAccountName(session)
+		if err != nil {
+			return admin_api.NewDownloadImportedUsersSecretsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		secrets := globalGeneratedSecrets.take(params.Token, account, time.Now())
+		if secrets == nil {
+			return admin_api.NewDownloadImportedUsersSecretsDefault(404).WithPayload(&models.Error{Code: 404, Message: swag.String("secrets not found or already downloaded")})
+		}
+		data, err := writeUsersCSV([]string{"accessKey", "secretKey"}, secrets.credentials)
+		if err != nil {
+			return admin_api.NewDownloadImportedUsersSecretsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return csvResponder("secrets.csv", data)
+	})
+	// Export users as CSV
+	api.AdminAPIExportUsersHandler = admin_api.ExportUsersHandlerFunc(func(params admin_api.ExportUsersParams, session *models.Principal) middleware.Responder {
+		data, err := getExportUsersResponse(session)
+		if err != nil {
+			return admin_api.NewExportUsersDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return csvResponder("users.csv", data)
+	})
+}
+
+// csvResponder is a custom response writer sending the data as a CSV attachment
+func csvResponder(fileName string, data []byte) middleware.Responder {
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", attachmentDisposition(fileName))
+		if _, err := w.Write(data); err != nil {
+			log.Println(err)
+		}
+	})
+}
+
+// writeUsersCSV returns the records as CSV with a header row
+func writeUsersCSV(header []string, records [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parseImportUsersCSV reads the users of the import CSV. The first row names the columns, in any order,
+// and only accessKey is mandatory. Groups and policies are comma separated lists. Values that can't be
+// parsed are recorded as errors of their row so every problem is reported at once.
+func parseImportUsersCSV(data string) ([]*importUserRow, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New(500, "error CSV has no header row")
+	}
+	if err != nil {
+		return nil, errors.New(500, "error invalid CSV: %v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		var column string
+		for _, c := range importUsersColumns {
+			if strings.EqualFold(c, name) {
+				column = c
+			}
+		}
+		if column == "" {
+			return nil, errors.New(500, "error unknown CSV column %s", name)
+		}
+		if _, ok := columns[column]; ok {
+			return nil, errors.New(500, "error CSV column %s is repeated", column)
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["accessKey"]; !ok {
+		return nil, errors.New(500, "error CSV column accessKey is required")
+	}
+
+	var rows []*importUserRow
+	for line := int64(2); ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New(500, "error invalid CSV: %v", err)
+		}
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		row := &importUserRow{
+			line:      line,
+			accessKey: value("accessKey"),
+			secretKey: value("secretKey"),
+			groups:    splitPolicyNames(value("groups")),
+			policies:  splitPolicyNames(value("policy")),
+			status:    value("status"),
+		}
+		if generateSecret := value("generateSecret"); generateSecret != "" {
+			if row.generateSecret, err = strconv.ParseBool(generateSecret); err != nil {
+				row.errors = append(row.errors, "generateSecret must be true or false")
+			}
+		} else {
+			// rows without a secret key, like the exported ones, get a generated secret
+			row.generateSecret = row.secretKey == ""
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New(500, "error CSV has no users")
+	}
+	if len(rows) > maxImportUsersRows {
+		return nil, errors.New(500, "error CSV has more than %d users", maxImportUsersRows)
+	}
+	return rows, nil
+}
+
+// validateImportUsers records on every row the problems that would make creating its user fail
+func validateImportUsers(rows []*importUserRow, users map[string]madmin.UserInfo, policies map[string]*iampolicy.Policy, groups []string) {
+	lines := map[string]int64{}
+	for _, row := range rows {
+		switch {
+		case row.accessKey == "":
+			row.errors = append(row.errors, "accessKey is required")
+		case len(row.accessKey) < minAccessKeyLength:
+			row.errors = append(row.errors, fmt.Sprintf("accessKey must be at least %d characters", minAccessKeyLength))
+		}
+		if line, ok := lines[row.accessKey]; ok && row.accessKey != "" {
+			row.errors = append(row.errors, fmt.Sprintf("accessKey is repeated on line %d", line))
+		} else {
+			lines[row.accessKey] = row.line
+		}
+		if _, ok := users[row.accessKey]; ok {
+			row.errors = append(row.errors, "user already exists")
+		}
+		switch {
+		case row.secretKey != "" && row.generateSecret:
+			row.errors = append(row.errors, "secretKey can't be set along with generateSecret")
+		case row.secretKey == "" && !row.generateSecret:
+			row.errors = append(row.errors, "secretKey or generateSecret is required")
+		case row.secretKey != "" && len(row.secretKey) < minSecretKeyLength:
+			row.errors = append(row.errors, fmt.Sprintf("secretKey must be at least %d characters", minSecretKeyLength))
+		}
+		switch row.status {
+		case "", "enabled", "disabled":
+		default:
+			row.errors = append(row.errors, "status must be enabled or disabled")
+		}
+		for _, policyName := range row.policies {
+			if _, ok := policies[policyName]; !ok {
+				row.errors = append(row.errors, fmt.Sprintf("policy %s doesn't exist", policyName))
+			}
+		}
+		for _, groupName := range row.groups {
+			if !IsElementInArray(groups, groupName) {
+				row.errors = append(row.errors, fmt.Sprintf("group %s doesn't exist", groupName))
+			}
+		}
+	}
+}
+
+// importUser creates the user of a row and sets it up, if setting it up fails the user is removed
+// so the row can be imported again and no user is left with a generated secret nobody knows.
+func importUser(ctx context.Context, client MinioAdmin, row *importUserRow) error {
+	if err := client.addUser(ctx, row.accessKey, row.secretKey); err != nil {
+		return err
+	}
+	if err := setupImportedUser(ctx, client, row); err != nil {
+		if errRemove := client.removeUser(ctx, row.accessKey); errRemove != nil {
+			return errors.New(500, "%v, the user was created but couldn't be removed: %v", err, errRemove)
+		}
+		return err
+	}
+	return nil
+}
+
+// setupImportedUser adds the user of a row to its groups and assigns its policies and status
+func setupImportedUser(ctx context.Context, client MinioAdmin, row *importUserRow) error {
+	if len(row.groups) > 0 {
+		if _, err := updateUserGroups(ctx, client, row.accessKey, row.groups); err != nil {
+			return err
+		}
+	}
+	if len(row.policies) > 0 {
+		if err := setPolicy(ctx, client, strings.Join(row.policies, ","), row.accessKey, models.PolicyEntityUser); err != nil {
+			return err
+		}
+	}
+	if row.status == string(madmin.AccountDisabled) {
+		if err := setUserStatus(ctx, client, row.accessKey, row.status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importUsers creates the users of the CSV using up to concurrency workers. Every row is validated first
+// and if any is invalid no user is created. A failed user doesn't stop the rest and results keep the
+// CSV order. Generated secret keys are never returned, they are kept to be downloaded once by the same user.
+func importUsers(ctx context.Context, client MinioAdmin, registry *generatedSecretsRegistry, createdBy string, req *models.ImportUsersRequest, now time.Time) (*models.ImportUsersResponse, error) {
+	if req.Concurrency < 0 || req.Concurrency > maxImportUsersConcurrency {
+		return nil, errors.New(500, "error concurrency must be between 1 and %d, or 0 to use the default", maxImportUsersConcurrency)
+	}
+	rows, err := parseImportUsersCSV(*req.Csv)
+	if err != nil {
+		return nil, err
+	}
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validateImportUsers(rows, users, policies, groups)
+
+	importResponse := &models.ImportUsersResponse{
+		Total:   int64(len(rows)),
+		Results: make([]*models.ImportUserResult, len(rows)),
+	}
+	for i, row := range rows {
+		importResponse.Results[i] = &models.ImportUserResult{Line: row.line, AccessKey: row.accessKey, Errors: row.errors}
+		if len(row.errors) > 0 {
+			importResponse.Invalid++
+		}
+	}
+	if importResponse.Invalid > 0 {
+		for _, result := range importResponse.Results {
+			result.Status = models.ImportUserResultStatusSkipped
+			if len(result.Errors) > 0 {
+				result.Status = models.ImportUserResultStatusInvalid
+			}
+		}
+		return importResponse, nil
+	}
+
+	for _, row := range rows {
+		if row.generateSecret {
+			row.secretKey = RandomCharString(generatedSecretKeyLength)
+		}
+	}
+	concurrency := int(req.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultImportUsersConcurrency
+	}
+	jobsCh := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// each row is processed by a single worker so results are written without locking
+			for i := range jobsCh {
+				if err := importUser(ctx, client, rows[i]); err != nil {
+					importResponse.Results[i].Errors = []string{err.Error()}
+				}
+			}
+		}()
+	}
+	for i := range rows {
+		jobsCh <- i
+	}
+	close(jobsCh)
+	wg.Wait()
+
+	secrets := &generatedSecrets{createdBy: createdBy, expiresAt: now.Add(generatedSecretsRetention)}
+	for i, result := range importResponse.Results {
+		if len(result.Errors) > 0 {
+			result.Status = models.ImportUserResultStatusFailed
+			importResponse.Failed++
+			continue
+		}
+		result.Status = models.ImportUserResultStatusCreated
+		importResponse.Created++
+		if rows[i].generateSecret {
+			result.SecretGenerated = true
+			secrets.credentials = append(secrets.credentials, []string{rows[i].accessKey, rows[i].secretKey})
+		}
+	}
+	if len(secrets.credentials) > 0 {
+		importResponse.SecretsToken = registry.add(secrets, now)
+		importResponse.SecretsExpiresAt = secrets.expiresAt.UTC().Format(time.RFC3339)
+	}
+	return importResponse, nil
+}
+
+// exportUsersCSV returns the users sorted by access key as CSV, the columns are the ones
+// accepted by importUsers() except the secret key, which can't be read. Importing the CSV
+// generates a new secret key for every user.
+func exportUsersCSV(ctx context.Context, client MinioAdmin) ([]byte, error) {
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var accessKeys []string
+	for accessKey := range users {
+		accessKeys = append(accessKeys, accessKey)
+	}
+	sort.Strings(accessKeys)
+	records := [][]string{}
+	for _, accessKey := range accessKeys {
+		user := users[accessKey]
+		records = append(records, []string{accessKey, strings.Join(user.MemberOf, ","), user.PolicyName, string(user.Status)})
+	}
+	return writeUsersCSV([]string{"accessKey", "groups", "policy", "status"}, records)
+}
+
+// getImportUsersResponse performs importUsers() and serializes it to the handler's output
+func getImportUsersResponse(session *models.Principal, params admin_api.ImportUsersParams) (*models.ImportUsersResponse, error) {
+	// every user takes a few requests, so imports are given more time than other requests
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+	account, err := getSessionAccountName(session)
+	if err != nil {
+		log.Println("error getting session account:", err)
+		return nil, err
+	}
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	importResponse, err := importUsers(ctx, adminClient, globalGeneratedSecrets, account, params.Body, time.Now())
+	if err != nil {
+		log.Println("error importing users:", err)
+		return nil, err
+	}
+	return importResponse, nil
+}
+
+// getExportUsersResponse performs exportUsersCSV() and serializes it to the handler's output
+func getExportUsersResponse(session *models.Principal) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	data, err := exportUsersCSV(ctx, adminClient)
+	if err != nil {
+		log.Println("error exporting users:", err)
+		return nil, err
+	}
+	return data, nil
+}
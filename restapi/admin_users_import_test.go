@@ -0,0 +1,220 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImportUsersCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: parseImportUsersCSV() reads the columns in any order
+	rows, err := parseImportUsersCSV("Status,accessKey,groups,generateSecret\ndisabled,alice,\"devs, ops\",true\n,bob,,maybe\n")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", "parseImportUsersCSV()", err.Error())
+		return
+	}
+	if assert.Equal(2, len(rows)) {
+		assert.Equal(&importUserRow{line: 2, accessKey: "alice", generateSecret: true, groups: []string{"devs", "ops"}, status: "disabled"}, rows[0])
+		assert.Equal(int64(3), rows[1].line)
+		assert.Equal([]string{"generateSecret must be true or false"}, rows[1].errors)
+	}
+
+	// Test-2: parseImportUsersCSV() generates the secrets missing on exported users unless told otherwise
+	rows, err = parseImportUsersCSV("accessKey,groups,policy,status\nalice,devs,readonly,enabled\n")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", "parseImportUsersCSV()", err.Error())
+		return
+	}
+	assert.True(rows[0].generateSecret)
+	rows, _ = parseImportUsersCSV("accessKey,secretKey,generateSecret\nalice,alice-secret,\nbob,,false\n")
+	assert.False(rows[0].generateSecret)
+	assert.False(rows[1].generateSecret)
+
+	// Test-3: parseImportUsersCSV() rejects unknown and missing columns
+	if _, err = parseImportUsersCSV("accessKey,password\nalice,secret\n"); assert.Error(err) {
+		assert.Equal("error unknown CSV column password", err.Error())
+	}
+	if _, err = parseImportUsersCSV("secretKey\nsecret123\n"); assert.Error(err) {
+		assert.Equal("error CSV column accessKey is required", err.Error())
+	}
+	if _, err = parseImportUsersCSV("accessKey\n"); assert.Error(err) {
+		assert.Equal("error CSV has no users", err.Error())
+	}
+}
+
+func TestImportUsers(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	function := "importUsers()"
+	now := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"admin": {Status: madmin.AccountEnabled}}, nil
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readonly": {}, "diagnostics": {}}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"devs"}, nil
+	}
+	var lock sync.Mutex
+	var calls []string
+	record := func(call string) {
+		lock.Lock()
+		defer lock.Unlock()
+		calls = append(calls, call)
+	}
+	secretKeys := map[string]string{}
+	minioAddUserMock = func(accessKey, secretKey string) error {
+		if accessKey == "carol" {
+			return errors.New("the access key is invalid")
+		}
+		lock.Lock()
+		secretKeys[accessKey] = secretKey
+		lock.Unlock()
+		record("addUser " + accessKey)
+		return nil
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{Status: madmin.AccountEnabled}, nil
+	}
+	minioUpdateGroupMembersMock = func(req madmin.GroupAddRemove) error {
+		record(fmt.Sprintf("updateGroupMembers %s %v", req.Group, req.Members))
+		return nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		if entityName == "dave" {
+			return errors.New("Access Denied.")
+		}
+		record(fmt.Sprintf("setPolicy %s %s", policyName, entityName))
+		return nil
+	}
+	minioRemoveUserMock = func(accessKey string) error {
+		record("removeUser " + accessKey)
+		return nil
+	}
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		record(fmt.Sprintf("setUserStatus %s %s", accessKey, status))
+		return nil
+	}
+
+	// Test-1: importUsers() doesn't create any user if a row is invalid
+	registry := &generatedSecretsRegistry{}
+	req := &models.ImportUsersRequest{Csv: swag.String("accessKey,secretKey,generateSecret,groups,policy,status\n" +
+		"alice,alice-secret,,devs,readonly,\n" +
+		"admin,,true,,,\n" +
+		"bob,short,,ops,missing,unknown\n" +
+		"alice,,true,,,\n")}
+	importResponse, err := importUsers(ctx, adminClient, registry, "admin", req, now)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(int64(3), importResponse.Invalid)
+	assert.Equal(int64(0), importResponse.Created)
+	assert.Equal("skipped", importResponse.Results[0].Status)
+	assert.Equal([]string{"user already exists"}, importResponse.Results[1].Errors)
+	assert.Equal([]string{"secretKey must be at least 8 characters", "status must be enabled or disabled", "policy missing doesn't exist", "group ops doesn't exist"}, importResponse.Results[2].Errors)
+	assert.Equal([]string{"accessKey is repeated on line 2"}, importResponse.Results[3].Errors)
+	assert.Nil(calls)
+
+	// Test-2: importUsers() creates the users and keeps the generated secrets to be downloaded once
+	req = &models.ImportUsersRequest{Csv: swag.String("accessKey,secretKey,generateSecret,groups,policy,status\n" +
+		"alice,alice-secret,,devs,\"readonly,diagnostics\",disabled\n" +
+		"bob,,true,,,\n" +
+		"carol,,true,,,\n" +
+		"dave,,true,,diagnostics,\n"), Concurrency: 2}
+	importResponse, err = importUsers(ctx, adminClient, registry, "admin", req, now)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(int64(2), importResponse.Created)
+	assert.Equal(int64(2), importResponse.Failed)
+	assert.Equal("created", importResponse.Results[0].Status)
+	assert.False(importResponse.Results[0].SecretGenerated)
+	assert.True(importResponse.Results[1].SecretGenerated)
+	assert.Equal("failed", importResponse.Results[2].Status)
+	assert.Equal([]string{"the access key is invalid"}, importResponse.Results[2].Errors)
+	assert.Equal("failed", importResponse.Results[3].Status)
+	assert.False(importResponse.Results[3].SecretGenerated)
+	assert.Equal([]string{"Access Denied."}, importResponse.Results[3].Errors)
+	sort.Strings(calls)
+	assert.Equal([]string{
+		"addUser alice",
+		"addUser bob",
+		"addUser dave",
+		"removeUser dave",
+		"setPolicy readonly,diagnostics alice",
+		"setUserStatus alice disabled",
+		"updateGroupMembers devs [alice]",
+	}, calls)
+	assert.Equal("alice-secret", secretKeys["alice"])
+	assert.Equal(generatedSecretKeyLength, len(secretKeys["bob"]))
+	assert.Equal("2020-08-01T10:15:00Z", importResponse.SecretsExpiresAt)
+
+	// Test-3: generated secrets are downloaded only once and only by the user that imported them
+	assert.Nil(registry.take(importResponse.SecretsToken, "other", now))
+	secrets := registry.take(importResponse.SecretsToken, "admin", now)
+	if assert.NotNil(secrets) {
+		assert.Equal([][]string{{"bob", secretKeys["bob"]}}, secrets.credentials)
+	}
+	assert.Nil(registry.take(importResponse.SecretsToken, "admin", now))
+
+	// Test-4: importUsers() handles errors listing users correctly
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return nil, errors.New("error")
+	}
+	if _, err = importUsers(ctx, adminClient, registry, "admin", req, now); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestExportUsersCSV(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+
+	// Test-1: exportUsersCSV() returns the users sorted by access key
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"bob":   {Status: madmin.AccountDisabled},
+			"alice": {PolicyName: "readonly,diagnostics", MemberOf: []string{"devs", "ops"}, Status: madmin.AccountEnabled},
+		}, nil
+	}
+	data, err := exportUsersCSV(ctx, adminClient)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", "exportUsersCSV()", err.Error())
+		return
+	}
+	assert.Equal("accessKey,groups,policy,status\nalice,\"devs,ops\",\"readonly,diagnostics\",enabled\nbob,,,disabled\n", string(data))
+}
@@ -19,8 +19,11 @@ package restapi
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/minio/console/models"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
 	"github.com/minio/minio/pkg/madmin"
 
 	"errors"
@@ -99,7 +102,7 @@ func TestListUsers(t *testing.T) {
 	for _, b := range userMap {
 		assert.Contains(mockUserMap, b.AccessKey)
 		assert.Equal(string(mockUserMap[b.AccessKey].Status), b.Status)
-		assert.Equal(mockUserMap[b.AccessKey].PolicyName, b.Policy)
+		assert.Equal([]string{mockUserMap[b.AccessKey].PolicyName}, b.Policy)
 		assert.ElementsMatch(mockUserMap[b.AccessKey].MemberOf, []string{"group1", "group2"})
 	}
 
@@ -328,6 +331,86 @@ func TestGetUserInfo(t *testing.T) {
 	}
 }
 
+func TestGetUserEffectivePolicy(t *testing.T) {
+	assert := asrt.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	function := "getUserEffectivePolicy()"
+
+	policies := map[string]string{
+		"readonly":  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:GetBucketLocation"],"Resource":["arn:aws:s3:::*"]}]}`,
+		"readonly2": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetBucketLocation","s3:GetObject"],"Resource":["arn:aws:s3:::*"]}]}`,
+		"nodelete":  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:DeleteObject"],"Resource":["arn:aws:s3:::bucket1/*"]}]}`,
+		"admin":     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:*"]}]}`,
+	}
+	var fetched []string
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		fetched = append(fetched, name)
+		raw, ok := policies[name]
+		if !ok {
+			return nil, errors.New("policy does not exist")
+		}
+		return iampolicy.ParseConfig(strings.NewReader(raw))
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "readonly", MemberOf: []string{"group1", "group2"}, Status: madmin.AccountEnabled}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "group1" {
+			return &madmin.GroupDesc{Name: group, Policy: "readonly2,nodelete", Status: "enabled"}, nil
+		}
+		return &madmin.GroupDesc{Name: group, Policy: "admin", Status: "disabled"}, nil
+	}
+
+	// Test-1: getUserEffectivePolicy() merges the policies of the user and of its enabled groups
+	effectivePolicy, err := getUserEffectivePolicy(ctx, adminClient, "user1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("user1", effectivePolicy.AccessKey)
+	assert.Equal("enabled", effectivePolicy.Status)
+	assert.Equal([]string{"readonly", "readonly2", "nodelete"}, effectivePolicy.Policies)
+	assert.Equal([]string{"readonly", "readonly2", "nodelete"}, fetched)
+	if assert.Equal(2, len(effectivePolicy.Groups)) {
+		assert.True(effectivePolicy.Groups[0].Applied)
+		assert.Equal([]string{"readonly2", "nodelete"}, effectivePolicy.Groups[0].Policies)
+		assert.False(effectivePolicy.Groups[1].Applied)
+		assert.Equal([]string{"admin"}, effectivePolicy.Groups[1].Policies)
+	}
+	if assert.Equal(2, len(effectivePolicy.Statements), fmt.Sprintf("Failed on %s: number of statements is not the same", function)) {
+		assert.Equal("Allow", effectivePolicy.Statements[0].Effect)
+		assert.Equal([]*models.PolicySource{{Policy: "readonly"}, {Policy: "readonly2", Group: "group1"}}, effectivePolicy.Statements[0].Sources)
+		assert.Equal("Deny", effectivePolicy.Statements[1].Effect)
+		assert.Equal([]*models.PolicySource{{Policy: "nodelete", Group: "group1"}}, effectivePolicy.Statements[1].Sources)
+	}
+	merged, err := iampolicy.ParseConfig(strings.NewReader(effectivePolicy.Policy))
+	if assert.NoError(err) {
+		assert.Equal(2, len(merged.Statements))
+	}
+
+	// Test-2: getUserEffectivePolicy() returns an empty policy for a user without policies
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{Status: madmin.AccountDisabled}, nil
+	}
+	effectivePolicy, err = getUserEffectivePolicy(ctx, adminClient, "user2")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("disabled", effectivePolicy.Status)
+	assert.Equal(0, len(effectivePolicy.Statements))
+	assert.Equal(0, len(effectivePolicy.Groups))
+
+	// Test-3: getUserEffectivePolicy() handles missing policies correctly
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "missing", Status: madmin.AccountEnabled}, nil
+	}
+	if _, err = getUserEffectivePolicy(ctx, adminClient, "user3"); assert.Error(err) {
+		assert.Equal("error getting policy missing: policy does not exist", err.Error())
+	}
+}
+
 func TestSetUserStatus(t *testing.T) {
 	assert := asrt.New(t)
 	adminClient := adminClientMock{}
@@ -97,6 +97,13 @@ type MinioAdmin interface {
 	addServiceAccount(ctx context.Context, policy *iampolicy.Policy) (mauth.Credentials, error)
 	listServiceAccounts(ctx context.Context) (madmin.ListServiceAccountsResp, error)
 	deleteServiceAccount(ctx context.Context, serviceAccount string) error
+	// Remote Targets
+	listRemoteTargets(ctx context.Context, bucketName, arnType string) ([]madmin.BucketTarget, error)
+	setRemoteTarget(ctx context.Context, bucketName string, target *madmin.BucketTarget) (string, error)
+	removeRemoteTarget(ctx context.Context, bucketName, arn string) error
+	// Bucket Quotas
+	getBucketQuota(ctx context.Context, bucketName string) (madmin.BucketQuota, error)
+	setBucketQuota(ctx context.Context, bucketName string, quota *madmin.BucketQuota) error
 }
 
 // Interface implementation
@@ -237,6 +244,31 @@ func (ac adminClient) deleteServiceAccount(ctx context.Context, serviceAccount s
 	return ac.client.DeleteServiceAccount(ctx, serviceAccount)
 }
 
+// implements madmin.ListRemoteTargets()
+func (ac adminClient) listRemoteTargets(ctx context.Context, bucketName, arnType string) ([]madmin.BucketTarget, error) {
+	return ac.client.ListRemoteTargets(ctx, bucketName, arnType)
+}
+
+// implements madmin.SetRemoteTarget()
+func (ac adminClient) setRemoteTarget(ctx context.Context, bucketName string, target *madmin.BucketTarget) (string, error) {
+	return ac.client.SetRemoteTarget(ctx, bucketName, target)
+}
+
+// implements madmin.RemoveRemoteTarget()
+func (ac adminClient) removeRemoteTarget(ctx context.Context, bucketName, arn string) error {
+	return ac.client.RemoveRemoteTarget(ctx, bucketName, arn)
+}
+
+// implements madmin.GetBucketQuota()
+func (ac adminClient) getBucketQuota(ctx context.Context, bucketName string) (madmin.BucketQuota, error) {
+	return ac.client.GetBucketQuota(ctx, bucketName)
+}
+
+// implements madmin.SetBucketQuota()
+func (ac adminClient) setBucketQuota(ctx context.Context, bucketName string, quota *madmin.BucketQuota) error {
+	return ac.client.SetBucketQuota(ctx, bucketName, quota)
+}
+
 // implements madmin.AccountingUsageInfo()
 func (ac adminClient) accountUsageInfo(ctx context.Context) (madmin.AccountUsageInfo, error) {
 	return ac.client.AccountUsageInfo(ctx)
@@ -19,7 +19,10 @@ package restapi
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/url"
 	"strings"
+	"time"
 
 	"errors"
 
@@ -32,7 +35,11 @@ import (
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
 	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
 )
 
 func init() {
@@ -46,11 +53,60 @@ func init() {
 // that are used within this project.
 type MinioClient interface {
 	listBucketsWithContext(ctx context.Context) ([]minio.BucketInfo, error)
-	makeBucketWithContext(ctx context.Context, bucketName, location string) error
+	makeBucketWithContext(ctx context.Context, bucketName, location string, objectLocking bool) error
 	setBucketPolicyWithContext(ctx context.Context, bucketName, policy string) error
 	removeBucket(ctx context.Context, bucketName string) error
 	getBucketNotification(ctx context.Context, bucketName string) (config notification.Configuration, err error)
 	getBucketPolicy(ctx context.Context, bucketName string) (string, error)
+	listObjectsV2(ctx context.Context, bucketName, prefix, continuationToken, delimiter string, maxKeys int) (minio.ListBucketV2Result, error)
+	putObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error)
+	listObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
+	removeObjects(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError
+	presignedGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error)
+	presignedPutObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (*url.URL, error)
+	getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
+	setBucketVersioning(ctx context.Context, bucketName string, config minio.BucketVersioningConfiguration) error
+	copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error)
+	getBucketLifecycle(ctx context.Context, bucketName string) (*lifecycle.Configuration, error)
+	setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+	getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error)
+	setBucketReplication(ctx context.Context, bucketName string, config replication.Config) error
+	removeBucketReplication(ctx context.Context, bucketName string) error
+	getBucketEncryption(ctx context.Context, bucketName string) (*sse.Configuration, error)
+	setBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) error
+	removeBucketEncryption(ctx context.Context, bucketName string) error
+	getObjectLockConfig(ctx context.Context, bucketName string) (objectLock string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
+	setObjectLockConfig(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error
+	statObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+	getObjectTagging(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error)
+	putObjectTagging(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error
+	removeObjectTagging(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectTaggingOptions) error
+	putObjectLegalHold(ctx context.Context, bucketName, objectName string, opts minio.PutObjectLegalHoldOptions) error
+	putObjectRetention(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error
+	selectObjectContent(ctx context.Context, bucketName, objectName string, opts minio.SelectObjectOptions) (selectResults, error)
+	getBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error)
+	setBucketTagging(ctx context.Context, bucketName string, btags *tags.Tags) error
+	removeBucketTagging(ctx context.Context, bucketName string) error
+	setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error
+	listenBucketNotification(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info
+}
+
+// objectReader is implemented by *minio.Object, objects are seekable
+// so range requests only fetch the requested bytes from MinIO.
+type objectReader interface {
+	io.ReadSeeker
+	io.Closer
+	Stat() (minio.ObjectInfo, error)
+}
+
+// selectResults is implemented by *minio.SelectResults, stats and progress
+// are updated as the query result is read.
+type selectResults interface {
+	io.ReadCloser
+	Stats() *minio.StatsMessage
+	Progress() *minio.ProgressMessage
 }
 
 // Interface implementation
@@ -66,10 +122,11 @@ func (c minioClient) listBucketsWithContext(ctx context.Context) ([]minio.Bucket
 	return c.client.ListBuckets(ctx)
 }
 
-// implements minio.MakeBucketWithContext(ctx, bucketName, location)
-func (c minioClient) makeBucketWithContext(ctx context.Context, bucketName, location string) error {
+// implements minio.MakeBucketWithContext(ctx, bucketName, location, objectLocking)
+func (c minioClient) makeBucketWithContext(ctx context.Context, bucketName, location string, objectLocking bool) error {
 	return c.client.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{
-		Region: location,
+		Region:        location,
+		ObjectLocking: objectLocking,
 	})
 }
 
@@ -88,11 +145,186 @@ func (c minioClient) getBucketNotification(ctx context.Context, bucketName strin
 	return c.client.GetBucketNotification(ctx, bucketName)
 }
 
+// implements minio.SetBucketNotification(ctx, bucketName, config)
+func (c minioClient) setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error {
+	return c.client.SetBucketNotification(ctx, bucketName, config)
+}
+
+// implements minio.ListenBucketNotification(ctx, bucketName, prefix, suffix, events)
+func (c minioClient) listenBucketNotification(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info {
+	return c.client.ListenBucketNotification(ctx, bucketName, prefix, suffix, events)
+}
+
 // implements minio.GetBucketPolicy(bucketName)
 func (c minioClient) getBucketPolicy(ctx context.Context, bucketName string) (string, error) {
 	return c.client.GetBucketPolicy(ctx, bucketName)
 }
 
+// implements minio.Core.ListObjectsV2(bucketName, prefix, continuationToken, fetchOwner, delimiter, maxKeys)
+// the lower level call is used so the continuation token can be handed back to the caller for pagination
+func (c minioClient) listObjectsV2(ctx context.Context, bucketName, prefix, continuationToken, delimiter string, maxKeys int) (minio.ListBucketV2Result, error) {
+	core := minio.Core{Client: c.client}
+	return core.ListObjectsV2(bucketName, prefix, continuationToken, false, delimiter, maxKeys)
+}
+
+// implements minio.PutObject(ctx, bucketName, objectName, reader, objectSize, opts)
+func (c minioClient) putObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	return c.client.PutObject(ctx, bucketName, objectName, reader, objectSize, opts)
+}
+
+// implements minio.GetObject(ctx, bucketName, objectName, opts)
+func (c minioClient) getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error) {
+	object, err := c.client.GetObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// implements minio.ListObjects(ctx, bucketName, opts)
+func (c minioClient) listObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	return c.client.ListObjects(ctx, bucketName, opts)
+}
+
+// implements minio.RemoveObjects(ctx, bucketName, objectsCh, opts)
+func (c minioClient) removeObjects(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+	return c.client.RemoveObjects(ctx, bucketName, objectsCh, opts)
+}
+
+// implements minio.PresignedGetObject(ctx, bucketName, objectName, expiry, reqParams)
+func (c minioClient) presignedGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error) {
+	return c.client.PresignedGetObject(ctx, bucketName, objectName, expiry, reqParams)
+}
+
+// implements minio.PresignedPutObject(ctx, bucketName, objectName, expiry)
+func (c minioClient) presignedPutObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return c.client.PresignedPutObject(ctx, bucketName, objectName, expiry)
+}
+
+// implements minio.GetBucketVersioning(ctx, bucketName)
+func (c minioClient) getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+	return c.client.GetBucketVersioning(ctx, bucketName)
+}
+
+// implements minio.SetBucketVersioning(ctx, bucketName, config)
+func (c minioClient) setBucketVersioning(ctx context.Context, bucketName string, config minio.BucketVersioningConfiguration) error {
+	return c.client.SetBucketVersioning(ctx, bucketName, config)
+}
+
+// implements minio.CopyObject(ctx, dst, src)
+func (c minioClient) copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return c.client.CopyObject(ctx, dst, src)
+}
+
+// implements minio.ComposeObject(ctx, dst, srcs...)
+func (c minioClient) composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return c.client.ComposeObject(ctx, dst, srcs...)
+}
+
+// implements minio.GetBucketLifecycle(ctx, bucketName)
+func (c minioClient) getBucketLifecycle(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+	return c.client.GetBucketLifecycle(ctx, bucketName)
+}
+
+// implements minio.SetBucketLifecycle(ctx, bucketName, config)
+func (c minioClient) setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+	return c.client.SetBucketLifecycle(ctx, bucketName, config)
+}
+
+// implements minio.GetBucketReplication(ctx, bucketName)
+func (c minioClient) getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
+	return c.client.GetBucketReplication(ctx, bucketName)
+}
+
+// implements minio.SetBucketReplication(ctx, bucketName, config)
+func (c minioClient) setBucketReplication(ctx context.Context, bucketName string, config replication.Config) error {
+	return c.client.SetBucketReplication(ctx, bucketName, config)
+}
+
+// implements minio.RemoveBucketReplication(ctx, bucketName)
+func (c minioClient) removeBucketReplication(ctx context.Context, bucketName string) error {
+	return c.client.RemoveBucketReplication(ctx, bucketName)
+}
+
+// implements minio.GetBucketEncryption(ctx, bucketName)
+func (c minioClient) getBucketEncryption(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+	return c.client.GetBucketEncryption(ctx, bucketName)
+}
+
+// implements minio.SetBucketEncryption(ctx, bucketName, config)
+func (c minioClient) setBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) error {
+	return c.client.SetBucketEncryption(ctx, bucketName, config)
+}
+
+// implements minio.RemoveBucketEncryption(ctx, bucketName)
+func (c minioClient) removeBucketEncryption(ctx context.Context, bucketName string) error {
+	return c.client.RemoveBucketEncryption(ctx, bucketName)
+}
+
+// implements minio.GetObjectLockConfig(ctx, bucketName)
+func (c minioClient) getObjectLockConfig(ctx context.Context, bucketName string) (objectLock string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error) {
+	return c.client.GetObjectLockConfig(ctx, bucketName)
+}
+
+// implements minio.SetObjectLockConfig(ctx, bucketName, mode, validity, unit)
+func (c minioClient) setObjectLockConfig(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+	return c.client.SetObjectLockConfig(ctx, bucketName, mode, validity, unit)
+}
+
+// implements minio.StatObject(ctx, bucketName, objectName, opts)
+func (c minioClient) statObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return c.client.StatObject(ctx, bucketName, objectName, opts)
+}
+
+// implements minio.GetObjectTagging(ctx, bucketName, objectName, opts)
+func (c minioClient) getObjectTagging(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+	return c.client.GetObjectTagging(ctx, bucketName, objectName, opts)
+}
+
+// implements minio.PutObjectTagging(ctx, bucketName, objectName, otags, opts)
+func (c minioClient) putObjectTagging(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error {
+	return c.client.PutObjectTagging(ctx, bucketName, objectName, otags, opts)
+}
+
+// implements minio.RemoveObjectTagging(ctx, bucketName, objectName, opts)
+func (c minioClient) removeObjectTagging(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectTaggingOptions) error {
+	return c.client.RemoveObjectTagging(ctx, bucketName, objectName, opts)
+}
+
+// implements minio.PutObjectLegalHold(ctx, bucketName, objectName, opts)
+func (c minioClient) putObjectLegalHold(ctx context.Context, bucketName, objectName string, opts minio.PutObjectLegalHoldOptions) error {
+	return c.client.PutObjectLegalHold(ctx, bucketName, objectName, opts)
+}
+
+// implements minio.PutObjectRetention(ctx, bucketName, objectName, opts)
+func (c minioClient) putObjectRetention(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error {
+	return c.client.PutObjectRetention(ctx, bucketName, objectName, opts)
+}
+
+// implements minio.SelectObjectContent(ctx, bucketName, objectName, opts)
+func (c minioClient) selectObjectContent(ctx context.Context, bucketName, objectName string, opts minio.SelectObjectOptions) (selectResults, error) {
+	results, err := c.client.SelectObjectContent(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// implements minio.GetBucketTagging(ctx, bucketName)
+func (c minioClient) getBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error) {
+	return c.client.GetBucketTagging(ctx, bucketName)
+}
+
+// implements minio.SetBucketTagging(ctx, bucketName, btags)
+func (c minioClient) setBucketTagging(ctx context.Context, bucketName string, btags *tags.Tags) error {
+	return c.client.SetBucketTagging(ctx, bucketName, btags)
+}
+
+// implements minio.RemoveBucketTagging(ctx, bucketName)
+func (c minioClient) removeBucketTagging(ctx context.Context, bucketName string) error {
+	return c.client.RemoveBucketTagging(ctx, bucketName)
+}
+
 // MCClient interface with all functions to be implemented
 // by mock when testing, it should include all mc/S3Client respective api calls
 // that are used within this project.
@@ -121,6 +121,16 @@ func GetTLSPort() int {
 	return port
 }
 
+// getPreviewMaxSize gets the size in bytes of the biggest object that can be previewed,
+// set on env variable or default one
+func getPreviewMaxSize() int64 {
+	maxSize, err := strconv.ParseInt(env.Get(ConsolePreviewMaxSize, ""), 10, 64)
+	if err != nil || maxSize <= 0 {
+		return defaultPreviewMaxSize
+	}
+	return maxSize
+}
+
 // Get secure middleware env variable configurations
 func getSecureAllowedHosts() []string {
 	allowedHosts := env.Get(ConsoleSecureAllowedHosts, "")
@@ -56,6 +56,8 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	// api.Logger = log.Printf
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	// upload bodies are streamed by the handler itself instead of being parsed by the framework
+	api.MultipartformConsumer = runtime.DiscardConsumer
 
 	api.JSONProducer = runtime.JSONProducer()
 	// Applies when the "x-token" header is set
@@ -82,12 +84,48 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerLogoutHandlers(api)
 	// Register bucket handlers
 	registerBucketsHandlers(api)
+	// Register bucket lifecycle handlers
+	registerBucketLifecycleHandlers(api)
+	// Register bucket policy handlers
+	registerBucketPolicyHandlers(api)
+	// Register bucket tags handlers
+	registerBucketTagsHandlers(api)
+	// Register bucket quota handlers
+	registerBucketQuotaHandlers(api)
+	// Register bucket encryption handlers
+	registerBucketEncryptionHandlers(api)
+	// Register bucket retention handlers
+	registerBucketRetentionHandlers(api)
+	// Register bucket replication handlers
+	registerBucketReplicationHandlers(api)
+	// Register objects handlers
+	registerObjectsHandlers(api)
+	// Register object versions handlers
+	registerObjectVersionsHandlers(api)
+	// Register object metadata handlers
+	registerObjectMetadataHandlers(api)
+	// Register objects copy handlers
+	registerObjectsCopyHandlers(api)
+	// Register bulk objects handlers
+	registerObjectsBulkHandlers(api)
+	// Register S3 Select handlers
+	registerObjectSelectHandlers(api)
+	// Register object preview handlers
+	registerObjectPreviewHandlers(api)
+	// Register share links handlers
+	registerShareHandlers(api)
 	// Register all users handlers
 	registerUsersHandlers(api)
+	// Register users import and export handlers
+	registerUsersImportHandlers(api)
 	// Register groups handlers
 	registerGroupsHandlers(api)
 	// Register policies handlers
 	registersPoliciesHandler(api)
+	// Register policy simulator handlers
+	registerPolicySimulatorHandlers(api)
+	// Register IAM snapshot handlers
+	registerIAMSnapshotHandlers(api)
 	// Register configurations handlers
 	registerConfigHandlers(api)
 	// Register bucket events handlers
@@ -102,6 +140,8 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerAdminInfoHandlers(api)
 	// Register admin arns handlers
 	registerAdminArnsHandlers(api)
+	// Register admin remote buckets handlers
+	registerAdminRemoteBucketsHandlers(api)
 	// Register admin notification endpoints handlers
 	registerAdminNotificationEndpointsHandlers(api)
 	// Register admin Service Account Handlers
@@ -28,6 +28,7 @@ const (
 	ConsolePort                  = "CONSOLE_PORT"
 	ConsoleTLSHostname           = "CONSOLE_TLS_HOSTNAME"
 	ConsoleTLSPort               = "CONSOLE_TLS_PORT"
+	ConsolePreviewMaxSize        = "CONSOLE_PREVIEW_MAX_SIZE"
 
 	// consts for Secure middleware
 	ConsoleSecureAllowedHosts                    = "CONSOLE_SECURE_ALLOWED_HOSTS"
@@ -75,6 +75,29 @@ func init() {
         }
       }
     },
+    "/admin/bucket-quotas": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns the quota and usage of every bucket",
+        "operationId": "ListBucketQuotas",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketQuotasResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/admin/info": {
       "get": {
         "tags": [
@@ -152,6 +175,102 @@ func init() {
         }
       }
     },
+    "/admin/remote-buckets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns the remote buckets registered as targets of local buckets",
+        "operationId": "ListRemoteBuckets",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "only list the targets of this source bucket",
+            "name": "bucket_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listRemoteBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Registers a remote bucket as a replication target of a local bucket",
+        "operationId": "AddRemoteBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRemoteBucket"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/remoteBucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/remote-buckets/{bucket_name}/{arn}": {
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Removes a remote bucket target",
+        "operationId": "DeleteRemoteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/buckets": {
       "get": {
         "tags": [
@@ -162,20 +281,59 @@ func init() {
         "parameters": [
           {
             "type": "string",
+            "description": "only returns buckets whose name contains this text, case insensitive",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only returns buckets whose name starts with this prefix",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "name, size or creation_date, buckets are sorted by name if not set",
             "name": "sort_by",
             "in": "query"
           },
+          {
+            "type": "string",
+            "description": "asc or desc, asc if not set",
+            "name": "sort_order",
+            "in": "query"
+          },
           {
             "type": "integer",
             "format": "int32",
+            "description": "number of matching buckets to skip",
             "name": "offset",
             "in": "query"
           },
           {
             "type": "integer",
             "format": "int32",
+            "description": "maximum number of buckets to return, every matching bucket if not set",
             "name": "limit",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only returns buckets having this tag",
+            "name": "tag_key",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "value the tag set on tag_key must have, any value if not set",
+            "name": "tag_value",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "returns the buckets along with their tags, always done when tag_key is set",
+            "name": "include_tags",
+            "in": "query"
           }
         ],
         "responses": {
@@ -222,6 +380,68 @@ func init() {
         }
       }
     },
+    "/buckets/{bucket_name}/encryption": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets the default server-side encryption of a bucket",
+        "operationId": "SetBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEncryption"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Removes the default server-side encryption of a bucket",
+        "operationId": "DisableBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/buckets/{bucket_name}/events": {
       "get": {
         "tags": [
@@ -341,26 +561,46 @@ func init() {
         }
       }
     },
-    "/buckets/{name}": {
-      "get": {
+    "/buckets/{bucket_name}/events/{arn}/{id}": {
+      "put": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
+        "summary": "Replaces the events, prefix and suffix of a bucket notification rule",
+        "operationId": "UpdateBucketEvent",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationUpdateRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/notificationConfig"
             }
           },
           "default": {
@@ -370,24 +610,41 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/events/{arn}/{id}/test": {
+      "post": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Delete Bucket",
-        "operationId": "DeleteBucket",
+        "summary": "Writes and deletes a probe object matching a notification rule and reports the events sent for it",
+        "operationId": "TestBucketEvent",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notificationTestResult"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -398,34 +655,26 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/set-policy": {
-      "put": {
+    "/buckets/{bucket_name}/lifecycle": {
+      "get": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Bucket Set Policy",
-        "operationId": "BucketSetPolicy",
+        "summary": "Get the lifecycle rules of a bucket",
+        "operationId": "GetBucketLifecycle",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketPolicyRequest"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/bucketLifecycleResponse"
             }
           },
           "default": {
@@ -435,34 +684,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/configs": {
-      "get": {
+      },
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Configurations",
-        "operationId": "ListConfig",
+        "summary": "Replace every lifecycle rule of a bucket",
+        "operationId": "SetBucketLifecycle",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketLifecycleRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listConfigResponse"
+              "$ref": "#/definitions/bucketLifecycleResponse"
+            }
+          },
+          "400": {
+            "description": "One or more rules are not valid.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleValidationErrors"
             }
           },
           "default": {
@@ -472,28 +727,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/configs/{name}": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Configuration info",
-        "operationId": "ConfigInfo",
+        "summary": "Add a lifecycle rule to a bucket",
+        "operationId": "AddBucketLifecycleRule",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/configuration"
+              "$ref": "#/definitions/lifecycleRule"
+            }
+          },
+          "400": {
+            "description": "The rule is not valid.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleValidationErrors"
             }
           },
           "default": {
@@ -503,17 +770,25 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle/{rule_id}": {
       "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Set Configuration",
-        "operationId": "SetConfig",
+        "summary": "Update a lifecycle rule of a bucket",
+        "operationId": "UpdateBucketLifecycleRule",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
             "in": "path",
             "required": true
           },
@@ -522,9 +797,50 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setConfigRequest"
+              "$ref": "#/definitions/lifecycleRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
+            }
+          },
+          "400": {
+            "description": "The rule is not valid.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleValidationErrors"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete a lifecycle rule of a bucket",
+        "operationId": "DeleteBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          }
         ],
         "responses": {
           "204": {
@@ -539,24 +855,44 @@ func init() {
         }
       }
     },
-    "/groups": {
+    "/buckets/{bucket_name}/objects": {
       "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Groups",
-        "operationId": "ListGroups",
+        "summary": "List Objects",
+        "operationId": "ListObjects",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "delimiter",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "continuation_token",
             "in": "query"
           },
           {
             "type": "integer",
             "format": "int32",
-            "name": "limit",
+            "name": "max_keys",
             "in": "query"
           }
         ],
@@ -564,7 +900,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listGroupsResponse"
+              "$ref": "#/definitions/listObjectsResponse"
             }
           },
           "default": {
@@ -574,26 +910,38 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets/{bucket_name}/objects/bulk": {
       "post": {
+        "description": "The manifest is a CSV with a key and an optional version id per line, or a JSON array of objects with key and version_id. The result of every entry is reported in manifest order.",
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Add Group",
-        "operationId": "AddGroup",
+        "summary": "Applies an action to every object of a manifest",
+        "operationId": "BulkObjects",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addGroupRequest"
+              "$ref": "#/definitions/bulkObjectsRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bulkObjectsResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -604,26 +952,34 @@ func init() {
         }
       }
     },
-    "/groups/{name}": {
-      "get": {
+    "/buckets/{bucket_name}/objects/copy": {
+      "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Group info",
-        "operationId": "GroupInfo",
+        "summary": "Starts a server-side copy or move of an object or a prefix",
+        "operationId": "CopyObjects",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/copyObjectsRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/copyOperation"
             }
           },
           "default": {
@@ -633,34 +989,34 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/copy/{operation_id}": {
+      "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Update Group Members or Status",
-        "operationId": "UpdateGroup",
+        "summary": "Returns the progress of a copy or move operation",
+        "operationId": "GetCopyOperation",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateGroupRequest"
-            }
+            "type": "string",
+            "name": "operation_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/copyOperation"
             }
           },
           "default": {
@@ -670,24 +1026,45 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
+        "description": "Streams the object back to the client honouring Range and If-None-Match request headers.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Remove group",
-        "operationId": "RemoveGroup",
+        "summary": "Download Object",
+        "operationId": "DownloadObject",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object to download",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object to download, defaults to the latest",
+            "name": "version_id",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -698,19 +1075,34 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/buckets/{bucket_name}/objects/download-zip": {
       "get": {
-        "security": [],
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "UserAPI"
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
+        "summary": "Download every object under a prefix as a zip archive",
+        "operationId": "DownloadObjectsZip",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "type": "file"
             }
           },
           "default": {
@@ -720,30 +1112,34 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/buckets/{bucket_name}/objects/folder": {
       "post": {
-        "security": [],
         "tags": [
           "UserAPI"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
+        "summary": "Creates an empty prefix so it's listed as a folder",
+        "operationId": "CreateFolder",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginRequest"
+              "$ref": "#/definitions/createFolderRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful login.",
-            "schema": {
-              "$ref": "#/definitions/loginResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -754,30 +1150,44 @@ func init() {
         }
       }
     },
-    "/login/oauth2/auth": {
-      "post": {
-        "security": [],
+    "/buckets/{bucket_name}/objects/legal-hold": {
+      "put": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Identity Provider oauth2 callback endpoint.",
-        "operationId": "LoginOauth2Auth",
+        "summary": "Sets or clears the legal hold of an object",
+        "operationId": "PutObjectLegalHold",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginOauth2AuthRequest"
+              "$ref": "#/definitions/putObjectLegalHoldRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful login.",
-            "schema": {
-              "$ref": "#/definitions/loginResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -788,50 +1198,39 @@ func init() {
         }
       }
     },
-    "/login/operator": {
-      "post": {
-        "security": [],
+    "/buckets/{bucket_name}/objects/metadata": {
+      "get": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Login to Operator Console.",
-        "operationId": "LoginOperator",
+        "summary": "Returns the user-defined metadata and system headers of an object",
+        "operationId": "GetObjectMetadata",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginOperatorRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful login.",
-            "schema": {
-              "$ref": "#/definitions/loginResponse"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
           }
-        }
-      }
-    },
-    "/logout": {
-      "post": {
-        "tags": [
-          "UserAPI"
         ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectMetadata"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -842,32 +1241,41 @@ func init() {
         }
       }
     },
-    "/namespaces/{namespace}/resourcequotas/{resource-quota-name}": {
+    "/buckets/{bucket_name}/objects/preview": {
       "get": {
+        "description": "Images are returned as they are with their detected content type, any other object is described by an objectPreview JSON document holding the beginning of text objects.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Get Resource Quota",
-        "operationId": "GetResourceQuota",
+        "summary": "Returns a bounded preview of an object",
+        "operationId": "PreviewObject",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "resource-quota-name",
-            "in": "path",
-            "required": true
+            "description": "name of the object to preview",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/resourceQuota"
+              "type": "file"
             }
           },
           "default": {
@@ -879,43 +1287,38 @@ func init() {
         }
       }
     },
-    "/namespaces/{namespace}/tenants": {
-      "get": {
+    "/buckets/{bucket_name}/objects/restore": {
+      "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Tenants by Namespace",
-        "operationId": "ListTenants",
+        "summary": "Restore an older version of an object as its latest version",
+        "operationId": "RestoreObjectVersion",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "sort_by",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
+            "description": "name of the object",
+            "name": "prefix",
             "in": "query"
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
+            "type": "string",
+            "description": "version to restore",
+            "name": "version_id",
             "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listTenantsResponse"
+              "$ref": "#/definitions/objectVersion"
             }
           },
           "default": {
@@ -927,33 +1330,44 @@ func init() {
         }
       }
     },
-    "/namespaces/{namespace}/tenants/{tenant}": {
-      "get": {
+    "/buckets/{bucket_name}/objects/retention": {
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Tenant Info",
-        "operationId": "TenantInfo",
+        "summary": "Sets or extends the retention of an object",
+        "operationId": "PutObjectRetention",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectRetentionRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/tenant"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -962,38 +1376,47 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/select": {
+      "post": {
+        "description": "Streams back newline delimited JSON events holding the matching records, the progress of the query when requested and its final stats.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Update Tenant",
-        "operationId": "UpdateTenant",
+        "summary": "Runs a SQL expression over a CSV, JSON or Parquet object",
+        "operationId": "SelectObjectContent",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
+            "description": "name of the object to query",
+            "name": "prefix",
+            "in": "query"
           },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateTenantRequest"
+              "$ref": "#/definitions/selectObjectRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1002,30 +1425,37 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/share": {
+      "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Delete Tenant",
-        "operationId": "DeleteTenant",
+        "summary": "Generate a presigned link to share an object",
+        "operationId": "ShareObject",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shareObjectRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/shareObjectResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1036,32 +1466,38 @@ func init() {
         }
       }
     },
-    "/namespaces/{namespace}/tenants/{tenant}/usage": {
+    "/buckets/{bucket_name}/objects/tags": {
       "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Get Usage For The Tenant",
-        "operationId": "GetTenantUsage",
+        "summary": "Returns the tags of an object",
+        "operationId": "GetObjectTags",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tenantUsage"
+              "$ref": "#/definitions/objectTags"
             }
           },
           "default": {
@@ -1071,40 +1507,47 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/namespaces/{namespace}/tenants/{tenant}/zones": {
-      "post": {
+      },
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Tenant Add Zone",
-        "operationId": "TenantAddZone",
+        "summary": "Replaces the tags of an object",
+        "operationId": "PutObjectTags",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
           },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/zone"
+              "$ref": "#/definitions/objectTags"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectTags"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1115,24 +1558,27 @@ func init() {
         }
       }
     },
-    "/policies": {
-      "get": {
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "description": "Streams every file of a multipart/form-data body into the bucket. An optional metadata field holding a JSON object of user metadata applies to the files that follow it.",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
+        "summary": "Uploads Objects",
+        "operationId": "UploadObjects",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
+            "type": "string",
+            "name": "prefix",
             "in": "query"
           }
         ],
@@ -1140,7 +1586,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/uploadObjectsResponse"
             }
           },
           "default": {
@@ -1150,28 +1596,34 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/versions": {
+      "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
+        "summary": "List every version and delete marker of an object",
+        "operationId": "ListObjectVersions",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addPolicyRequest"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
+              "$ref": "#/definitions/listObjectVersionsResponse"
             }
           },
           "default": {
@@ -1183,17 +1635,17 @@ func init() {
         }
       }
     },
-    "/policies/{name}": {
+    "/buckets/{bucket_name}/policy": {
       "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Policy info",
-        "operationId": "PolicyInfo",
+        "summary": "Returns the raw policy document of a bucket",
+        "operationId": "GetBucketPolicyDocument",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
@@ -1202,7 +1654,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
+              "$ref": "#/definitions/bucketPolicyDocument"
             }
           },
           "default": {
@@ -1213,23 +1665,40 @@ func init() {
           }
         }
       },
-      "delete": {
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Remove policy",
-        "operationId": "RemovePolicy",
+        "summary": "Validates and replaces the policy document of a bucket, an empty document removes the policy",
+        "operationId": "SetBucketPolicyDocument",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketPolicyDocument"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketPolicyDocument"
+            }
+          },
+          "400": {
+            "description": "The policy document is not valid.",
+            "schema": {
+              "$ref": "#/definitions/bucketPolicyValidationErrors"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1240,28 +1709,26 @@ func init() {
         }
       }
     },
-    "/profiling/start": {
-      "post": {
+    "/buckets/{bucket_name}/policy/anonymous": {
+      "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Start recording profile data",
-        "operationId": "ProfilingStart",
+        "summary": "Lists the anonymous access granted on each prefix of a bucket",
+        "operationId": "ListBucketAnonymousAccess",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/profilingStartRequest"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/startProfilingList"
+              "$ref": "#/definitions/bucketAnonymousAccessResponse"
             }
           },
           "default": {
@@ -1271,23 +1738,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/profiling/stop": {
-      "post": {
-        "produces": [
-          "application/octet-stream"
-        ],
+      },
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
+        ],
+        "summary": "Sets the anonymous access of a prefix keeping the rest of the bucket policy",
+        "operationId": "SetBucketAnonymousAccess",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/anonymousAccessRule"
+            }
+          }
         ],
-        "summary": "Stop and download profile data",
-        "operationId": "ProfilingStop",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/bucketAnonymousAccessResponse"
             }
           },
           "default": {
@@ -1299,32 +1777,26 @@ func init() {
         }
       }
     },
-    "/service-accounts": {
+    "/buckets/{bucket_name}/replication": {
       "get": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "List User's Service Accounts",
-        "operationId": "ListUserServiceAccounts",
+        "summary": "Get the replication rules of a bucket",
+        "operationId": "GetBucketReplication",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/bucketReplicationResponse"
             }
           },
           "default": {
@@ -1339,15 +1811,21 @@ func init() {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Create Service Account",
-        "operationId": "CreateServiceAccount",
+        "summary": "Add a replication rule to a bucket",
+        "operationId": "AddBucketReplicationRule",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
+              "$ref": "#/definitions/bucketReplicationRule"
             }
           }
         ],
@@ -1355,7 +1833,7 @@ func init() {
           "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/bucketReplicationRule"
             }
           },
           "default": {
@@ -1367,44 +1845,41 @@ func init() {
         }
       }
     },
-    "/service-accounts/{access_key}": {
-      "delete": {
+    "/buckets/{bucket_name}/replication/{rule_id}": {
+      "put": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Delete Service Account",
-        "operationId": "DeleteServiceAccount",
+        "summary": "Update a replication rule of a bucket",
+        "operationId": "UpdateBucketReplicationRule",
         "parameters": [
           {
             "type": "string",
-            "name": "access_key",
+            "name": "bucket_name",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
-          "default": {
-            "description": "Generic error response.",
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/bucketReplicationRule"
             }
           }
-        }
-      }
-    },
-    "/service/restart": {
-      "post": {
-        "tags": [
-          "AdminAPI"
         ],
-        "summary": "Restart Service",
-        "operationId": "RestartService",
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRule"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1413,21 +1888,30 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/session": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
+        "summary": "Delete a replication rule of a bucket",
+        "operationId": "DeleteBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/sessionResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -1438,17 +1922,17 @@ func init() {
         }
       }
     },
-    "/set-policy/{name}": {
+    "/buckets/{bucket_name}/retention": {
       "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Set policy",
-        "operationId": "SetPolicy",
+        "summary": "Sets the default retention of a bucket with object locking enabled",
+        "operationId": "SetBucketRetention",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
@@ -1457,7 +1941,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setPolicyRequest"
+              "$ref": "#/definitions/bucketRetention"
             }
           }
         ],
@@ -1474,37 +1958,26 @@ func init() {
         }
       }
     },
-    "/tenants": {
+    "/buckets/{bucket_name}/tags": {
       "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Tenant of All Namespaces",
-        "operationId": "ListAllTenants",
+        "summary": "Returns the tags of a bucket",
+        "operationId": "GetBucketTags",
         "parameters": [
           {
             "type": "string",
-            "name": "sort_by",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listTenantsResponse"
+              "$ref": "#/definitions/bucketTags"
             }
           },
           "default": {
@@ -1515,19 +1988,25 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Create Tenant",
-        "operationId": "CreateTenant",
+        "summary": "Replaces the tags of a bucket",
+        "operationId": "PutBucketTags",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createTenantRequest"
+              "$ref": "#/definitions/bucketTags"
             }
           }
         ],
@@ -1535,8 +2014,34 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/createTenantResponse"
+              "$ref": "#/definitions/bucketTags"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Removes every tag of a bucket",
+        "operationId": "DeleteBucketTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -1547,32 +2052,62 @@ func init() {
         }
       }
     },
-    "/users": {
-      "get": {
+    "/buckets/{bucket_name}/versioning": {
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Users",
-        "operationId": "ListUsers",
+        "summary": "Enable or suspend versioning on a bucket",
+        "operationId": "SetBucketVersioning",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketVersioningRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{name}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listUsersResponse"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -1583,27 +2118,67 @@ func init() {
           }
         }
       },
-      "post": {
+      "delete": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Add User",
-        "operationId": "AddUser",
+        "summary": "Delete Bucket",
+        "operationId": "DeleteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "remove every object of the bucket before deleting it",
+            "name": "force",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{name}/quota": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets, changes or removes the quota of a bucket",
+        "operationId": "SetBucketQuota",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addUserRequest"
+              "$ref": "#/definitions/bucketQuota"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/bucketQuota"
             }
           },
           "default": {
@@ -1615,26 +2190,35 @@ func init() {
         }
       }
     },
-    "/users-groups-bulk": {
+    "/buckets/{name}/set-policy": {
       "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
+        "summary": "Bucket Set Policy",
+        "operationId": "BucketSetPolicy",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
+              "$ref": "#/definitions/setBucketPolicyRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1645,26 +2229,32 @@ func init() {
         }
       }
     },
-    "/users/{name}": {
+    "/configs": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Get User Info",
-        "operationId": "GetUserInfo",
+        "summary": "List Configurations",
+        "operationId": "ListConfig",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/listConfigResponse"
             }
           },
           "default": {
@@ -1674,34 +2264,28 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/configs/{name}": {
+      "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Update User Info",
-        "operationId": "UpdateUserInfo",
+        "summary": "Configuration info",
+        "operationId": "ConfigInfo",
         "parameters": [
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUser"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/configuration"
             }
           },
           "default": {
@@ -1712,18 +2296,26 @@ func init() {
           }
         }
       },
-      "delete": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Remove user",
-        "operationId": "RemoveUser",
+        "summary": "Set Configuration",
+        "operationId": "SetConfig",
         "parameters": [
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setConfigRequest"
+            }
           }
         ],
         "responses": {
@@ -1739,34 +2331,32 @@ func init() {
         }
       }
     },
-    "/users/{name}/groups": {
-      "put": {
+    "/groups": {
+      "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
+        "summary": "List Groups",
+        "operationId": "ListGroups",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUserGroups"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/listGroupsResponse"
             }
           },
           "default": {
@@ -1776,1710 +2366,7222 @@ func init() {
             }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "addGroupRequest": {
-      "type": "object",
-      "required": [
-        "group",
-        "members"
-      ],
-      "properties": {
-        "group": {
-          "type": "string"
-        },
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "addPolicyRequest": {
-      "type": "object",
-      "required": [
-        "name",
-        "policy"
-      ],
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add Group",
+        "operationId": "AddGroup",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "addUserRequest": {
-      "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey",
-        "groups"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/groups/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Group info",
+        "operationId": "GroupInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "secretKey": {
-          "type": "string"
         }
-      }
-    },
-    "adminInfoResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "integer"
-        },
-        "objects": {
-          "type": "integer"
-        },
-        "usage": {
-          "type": "integer"
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update Group Members or Status",
+        "operationId": "UpdateGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove group",
+        "operationId": "RemoveGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "arnsResponse": {
-      "type": "object",
-      "properties": {
-        "arns": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/iam/snapshot": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Exports policies, users, groups and their policy mappings as a snapshot",
+        "operationId": "ExportIamSnapshot",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/iamSnapshot"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "awsConfiguration": {
-      "type": "object",
-      "required": [
-        "secretsmanager"
-      ],
-      "properties": {
-        "secretsmanager": {
-          "type": "object",
-          "required": [
-            "endpoint",
-            "region",
-            "credentials"
-          ],
-          "properties": {
-            "credentials": {
-              "type": "object",
-              "required": [
-                "accesskey",
-                "secretkey"
-              ],
-              "properties": {
-                "accesskey": {
-                  "type": "string"
-                },
-                "secretkey": {
-                  "type": "string"
-                },
-                "token": {
-                  "type": "string"
-                }
-              }
-            },
-            "endpoint": {
-              "type": "string"
-            },
-            "kmskey": {
-              "type": "string"
-            },
-            "region": {
-              "type": "string"
+    "/iam/snapshot/import": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Applies an IAM snapshot, or only returns the changes it would apply on dry run",
+        "operationId": "ImportIamSnapshot",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/iamSnapshotImportRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/iamSnapshotImportResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "bucket": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
-        },
-        "creation_date": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string",
-          "minLength": 3
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
+    "/login": {
+      "get": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/loginDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
-    },
-    "bucketEventRequest": {
-      "type": "object",
-      "required": [
-        "configuration"
-      ],
-      "properties": {
-        "configuration": {
-          "$ref": "#/definitions/notificationConfig"
-        },
-        "ignoreExisting": {
-          "type": "boolean"
+      },
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Login to Console",
+        "operationId": "Login",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bulkUserGroups": {
-      "type": "object",
-      "required": [
-        "users",
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOauth2AuthRequest"
+            }
           }
-        },
-        "users": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "configDescription": {
-      "type": "object",
-      "properties": {
-        "description": {
-          "type": "string"
-        },
-        "key": {
-          "type": "string"
+    "/login/operator": {
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Login to Operator Console.",
+        "operationId": "LoginOperator",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOperatorRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "configuration": {
-      "type": "object",
-      "properties": {
-        "key_values": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configurationKV"
+    "/logout": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "type": "string"
         }
       }
     },
-    "configurationKV": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "type": "string"
-        },
-        "value": {
-          "type": "string"
+    "/namespaces/{namespace}/resourcequotas/{resource-quota-name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get Resource Quota",
+        "operationId": "GetResourceQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "resource-quota-name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/resourceQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "createTenantRequest": {
-      "type": "object",
-      "required": [
-        "name",
-        "namespace",
-        "zones"
-      ],
-      "properties": {
-        "access_key": {
-          "type": "string"
-        },
-        "annotations": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "console_image": {
-          "type": "string"
-        },
-        "enable_console": {
-          "type": "boolean",
-          "default": true
-        },
-        "enable_tls": {
-          "type": "boolean",
+    "/namespaces/{namespace}/tenants": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Tenants by Namespace",
+        "operationId": "ListTenants",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTenantsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Tenant Info",
+        "operationId": "TenantInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenant"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update Tenant",
+        "operationId": "UpdateTenant",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateTenantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete Tenant",
+        "operationId": "DeleteTenant",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/usage": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get Usage For The Tenant",
+        "operationId": "GetTenantUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantUsage"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/zones": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Tenant Add Zone",
+        "operationId": "TenantAddZone",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/zone"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/policies": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/policies/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Policy info",
+        "operationId": "PolicyInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove policy",
+        "operationId": "RemovePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/profiling/start": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Start recording profile data",
+        "operationId": "ProfilingStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/profilingStartRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/startProfilingList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/profiling/stop": {
+      "post": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Stop and download profile data",
+        "operationId": "ProfilingStop",
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List User's Service Accounts",
+        "operationId": "ListUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Create Service Account",
+        "operationId": "CreateServiceAccount",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts/{access_key}": {
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Service Account",
+        "operationId": "DeleteServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service/restart": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Restart Service",
+        "operationId": "RestartService",
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/session": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/sessionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/set-policy/{name}": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Attaches or detaches policies of a user or group, the path accepts a comma separated list",
+        "operationId": "SetPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/shares": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List active share links",
+        "operationId": "ListShares",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listSharesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/simulate-policy": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Evaluates whether a user, group or service account can perform an action on a resource",
+        "operationId": "SimulatePolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policySimulationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policySimulationResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tenants": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Tenant of All Namespaces",
+        "operationId": "ListAllTenants",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTenantsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create Tenant",
+        "operationId": "CreateTenant",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createTenantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/createTenantResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Users",
+        "operationId": "ListUsers",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add User",
+        "operationId": "AddUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addUserRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users-export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Downloads the users with their groups, policies and status as CSV",
+        "operationId": "ExportUsers",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users-groups-bulk": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users-import": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Creates the users of a CSV file",
+        "operationId": "ImportUsers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/importUsersRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/importUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users-import/secrets/{token}": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Downloads once the secret keys generated by a users import as CSV",
+        "operationId": "DownloadImportedUsersSecrets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get User Info",
+        "operationId": "GetUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update User Info",
+        "operationId": "UpdateUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUser"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove user",
+        "operationId": "RemoveUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{name}/effective-policy": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns the policies of the user merged with the policies of its groups",
+        "operationId": "GetUserEffectivePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/userEffectivePolicy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{name}/groups": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "addGroupRequest": {
+      "type": "object",
+      "required": [
+        "group",
+        "members"
+      ],
+      "properties": {
+        "group": {
+          "type": "string"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "addPolicyRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "policy"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "addUserRequest": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey",
+        "groups"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "usage": {
+          "type": "integer"
+        }
+      }
+    },
+    "anonymousAccessRule": {
+      "type": "object",
+      "required": [
+        "access"
+      ],
+      "properties": {
+        "access": {
+          "type": "string",
+          "title": "none removes the anonymous access of the prefix",
+          "enum": [
+            "none",
+            "readonly",
+            "writeonly",
+            "readwrite"
+          ]
+        },
+        "prefix": {
+          "type": "string",
+          "title": "prefix the rule applies to, the whole bucket if empty"
+        }
+      }
+    },
+    "arnsResponse": {
+      "type": "object",
+      "properties": {
+        "arns": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "awsConfiguration": {
+      "type": "object",
+      "required": [
+        "secretsmanager"
+      ],
+      "properties": {
+        "secretsmanager": {
+          "type": "object",
+          "required": [
+            "endpoint",
+            "region",
+            "credentials"
+          ],
+          "properties": {
+            "credentials": {
+              "type": "object",
+              "required": [
+                "accesskey",
+                "secretkey"
+              ],
+              "properties": {
+                "accesskey": {
+                  "type": "string"
+                },
+                "secretkey": {
+                  "type": "string"
+                },
+                "token": {
+                  "type": "string"
+                }
+              }
+            },
+            "endpoint": {
+              "type": "string"
+            },
+            "kmskey": {
+              "type": "string"
+            },
+            "region": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "bucket": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "creation_date": {
+          "type": "string"
+        },
+        "encryption": {
+          "$ref": "#/definitions/bucketEncryption"
+        },
+        "name": {
+          "type": "string",
+          "minLength": 3
+        },
+        "object_locking": {
+          "type": "boolean"
+        },
+        "quota": {
+          "$ref": "#/definitions/bucketQuota"
+        },
+        "retention": {
+          "$ref": "#/definitions/bucketRetention"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "versioning": {
+          "type": "string",
+          "title": "versioning status, Enabled or Suspended, empty if it was never enabled"
+        }
+      }
+    },
+    "bucketAccess": {
+      "type": "string",
+      "default": "PRIVATE",
+      "enum": [
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
+      ]
+    },
+    "bucketAnonymousAccessResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/anonymousAccessRule"
+          }
+        }
+      }
+    },
+    "bucketEncryption": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "kms_key_id": {
+          "type": "string",
+          "title": "KMS master key used by sse-kms"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "sse-s3",
+            "sse-kms"
+          ]
+        }
+      }
+    },
+    "bucketEventRequest": {
+      "type": "object",
+      "required": [
+        "configuration"
+      ],
+      "properties": {
+        "configuration": {
+          "$ref": "#/definitions/notificationConfig"
+        },
+        "ignoreExisting": {
+          "type": "boolean"
+        }
+      }
+    },
+    "bucketLifecycleResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleRule"
+          }
+        }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "is_prefix": {
+          "type": "boolean",
+          "title": "true when the entry is a common prefix (folder) instead of an object"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketPolicyDocument": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "type": "string",
+          "title": "bucket policy as a JSON document, empty when the bucket has no policy"
+        }
+      }
+    },
+    "bucketPolicyError": {
+      "type": "object",
+      "properties": {
+        "column": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "line": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketPolicyValidationErrors": {
+      "type": "object",
+      "properties": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketPolicyError"
+          }
+        }
+      }
+    },
+    "bucketQuota": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "type": "integer",
+          "format": "int64",
+          "title": "quota in bytes, 0 removes the quota of the bucket",
+          "minimum": 0
+        },
+        "type": {
+          "type": "string",
+          "title": "hard quotas reject new writes, fifo quotas remove the oldest objects",
+          "enum": [
+            "hard",
+            "fifo"
+          ]
+        }
+      }
+    },
+    "bucketQuotaUsage": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string",
+          "title": "set if the quota of the bucket couldn't be read"
+        },
+        "exceeded": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "quota": {
+          "type": "integer",
+          "format": "int64",
+          "title": "quota in bytes, 0 if the bucket has no quota"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationRule"
+          }
+        }
+      }
+    },
+    "bucketReplicationRule": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string",
+          "title": "ARN of the remote bucket target, all the rules of a bucket share the same target"
+        },
+        "destination_bucket": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "title": "unique identifier of the rule, generated when empty"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32",
+          "title": "rules with higher priority win when several match an object, assigned when empty"
+        },
+        "status": {
+          "type": "string",
+          "title": "defaults to Enabled",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "storage_class": {
+          "type": "string",
+          "title": "storage class of the replicated objects"
+        },
+        "tags": {
+          "type": "string",
+          "title": "tags filter formatted as key1=value1\u0026key2=value2"
+        }
+      }
+    },
+    "bucketRetention": {
+      "type": "object",
+      "required": [
+        "mode",
+        "validity"
+      ],
+      "properties": {
+        "mode": {
+          "type": "string",
+          "enum": [
+            "governance",
+            "compliance"
+          ]
+        },
+        "unit": {
+          "type": "string",
+          "title": "unit of the validity, days if not set",
+          "enum": [
+            "days",
+            "years"
+          ]
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        }
+      }
+    },
+    "bucketTags": {
+      "type": "object",
+      "properties": {
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bulkObjectResult": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        },
+        "line": {
+          "type": "integer",
+          "format": "int64",
+          "title": "position of the entry in the manifest, starting at 1"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failed"
+          ]
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkObjectsRequest": {
+      "type": "object",
+      "required": [
+        "action",
+        "manifest"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "delete",
+            "copy",
+            "set_tags",
+            "set_legal_hold"
+          ]
+        },
+        "concurrency": {
+          "type": "integer",
+          "format": "int32",
+          "title": "number of objects processed at the same time, 8 if not set"
+        },
+        "destination_bucket": {
+          "type": "string",
+          "title": "bucket objects are copied to keeping their names, required for copy"
+        },
+        "legal_hold": {
+          "type": "string",
+          "title": "legal hold status set on every object, required for set_legal_hold",
+          "enum": [
+            "enabled",
+            "disabled"
+          ]
+        },
+        "manifest": {
+          "type": "string",
+          "title": "CSV or JSON list of objects"
+        },
+        "manifest_format": {
+          "type": "string",
+          "title": "format of the manifest, detected from its content if not set",
+          "enum": [
+            "csv",
+            "json"
+          ]
+        },
+        "manifest_header": {
+          "type": "boolean",
+          "title": "the first line of a CSV manifest is a header and is skipped"
+        },
+        "tags": {
+          "type": "object",
+          "title": "tags set on every object, required for set_tags, an empty set removes the tags",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bulkObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulkObjectResult"
+          }
+        },
+        "succeeded": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bulkUserGroups": {
+      "type": "object",
+      "required": [
+        "users",
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "configDescription": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "configuration": {
+      "type": "object",
+      "properties": {
+        "key_values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "configurationKV": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "copyObjectsRequest": {
+      "type": "object",
+      "required": [
+        "source",
+        "destination"
+      ],
+      "properties": {
+        "destination": {
+          "type": "string",
+          "title": "object name, or prefix ending with a slash"
+        },
+        "destination_bucket": {
+          "type": "string",
+          "title": "bucket to copy to, the source bucket if not set"
+        },
+        "move": {
+          "type": "boolean",
+          "title": "removes the sources once every object was copied"
+        },
+        "source": {
+          "type": "string",
+          "title": "object name, or prefix ending with a slash"
+        }
+      }
+    },
+    "copyOperation": {
+      "type": "object",
+      "properties": {
+        "copied": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "deleted": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "destination": {
+          "type": "string"
+        },
+        "destination_bucket": {
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "finished_at": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "move": {
+          "type": "boolean"
+        },
+        "source": {
+          "type": "string"
+        },
+        "source_bucket": {
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ]
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of objects found so far"
+        }
+      }
+    },
+    "createFolderRequest": {
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "path": {
+          "type": "string",
+          "title": "path of the folder, a trailing slash is added if missing"
+        }
+      }
+    },
+    "createRemoteBucket": {
+      "type": "object",
+      "required": [
+        "source_bucket",
+        "target_bucket",
+        "endpoint",
+        "access_key",
+        "secret_key"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string",
+          "minLength": 3
+        },
+        "endpoint": {
+          "type": "string",
+          "title": "host and port of the remote deployment e.g. minio2:9000",
+          "minLength": 1
+        },
+        "region": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string",
+          "minLength": 8
+        },
+        "secure": {
+          "type": "boolean",
+          "title": "use TLS to reach the remote deployment"
+        },
+        "source_bucket": {
+          "type": "string",
+          "minLength": 3
+        },
+        "target_bucket": {
+          "type": "string",
+          "minLength": 3
+        }
+      }
+    },
+    "createTenantRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "namespace",
+        "zones"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "console_image": {
+          "type": "string"
+        },
+        "enable_console": {
+          "type": "boolean",
+          "default": true
+        },
+        "enable_tls": {
+          "type": "boolean",
           "default": true
         },
-        "encryption": {
+        "encryption": {
+          "type": "object",
+          "$ref": "#/definitions/encryptionConfiguration"
+        },
+        "erasureCodingParity": {
+          "type": "integer"
+        },
+        "idp": {
+          "type": "object",
+          "$ref": "#/definitions/idpConfiguration"
+        },
+        "image": {
+          "type": "string"
+        },
+        "image_pull_secret": {
+          "type": "string"
+        },
+        "image_registry": {
+          "$ref": "#/definitions/imageRegistry"
+        },
+        "mounth_path": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "pattern": "^[a-z0-9-]{3,63}$"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        },
+        "service_name": {
+          "type": "string"
+        },
+        "tls": {
+          "type": "object",
+          "$ref": "#/definitions/tlsConfiguration"
+        },
+        "zones": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/zone"
+          }
+        }
+      }
+    },
+    "createTenantResponse": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "console": {
+          "type": "object",
+          "properties": {
+            "access_key": {
+              "type": "string"
+            },
+            "secret_key": {
+              "type": "string"
+            }
+          }
+        },
+        "secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "effectivePolicyGroup": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean",
+          "title": "false for disabled groups, their policies don't apply to the user"
+        },
+        "name": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "effectivePolicyStatement": {
+      "type": "object",
+      "properties": {
+        "effect": {
+          "type": "string"
+        },
+        "sources": {
+          "type": "array",
+          "title": "every policy having the statement",
+          "items": {
+            "$ref": "#/definitions/policySource"
+          }
+        },
+        "statement": {
+          "type": "string"
+        }
+      }
+    },
+    "encryptionConfiguration": {
+      "type": "object",
+      "properties": {
+        "aws": {
+          "type": "object",
+          "$ref": "#/definitions/awsConfiguration"
+        },
+        "client": {
+          "type": "object",
+          "$ref": "#/definitions/keyPairConfiguration"
+        },
+        "gemalto": {
+          "type": "object",
+          "$ref": "#/definitions/gemaltoConfiguration"
+        },
+        "image": {
+          "type": "string"
+        },
+        "server": {
+          "type": "object",
+          "$ref": "#/definitions/keyPairConfiguration"
+        },
+        "vault": {
+          "type": "object",
+          "$ref": "#/definitions/vaultConfiguration"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "gemaltoConfiguration": {
+      "type": "object",
+      "required": [
+        "keysecure"
+      ],
+      "properties": {
+        "keysecure": {
+          "type": "object",
+          "required": [
+            "endpoint",
+            "credentials"
+          ],
+          "properties": {
+            "credentials": {
+              "type": "object",
+              "required": [
+                "token",
+                "domain"
+              ],
+              "properties": {
+                "domain": {
+                  "type": "string"
+                },
+                "retry": {
+                  "type": "integer",
+                  "format": "int64"
+                },
+                "token": {
+                  "type": "string"
+                }
+              }
+            },
+            "endpoint": {
+              "type": "string"
+            },
+            "tls": {
+              "type": "object",
+              "required": [
+                "ca"
+              ],
+              "properties": {
+                "ca": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "array",
+          "title": "policies attached to the group",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamSnapshot": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamSnapshotGroup"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamSnapshotPolicy"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamSnapshotUser"
+          }
+        },
+        "version": {
+          "type": "integer",
+          "title": "version of the snapshot format"
+        }
+      }
+    },
+    "iamSnapshotChange": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete"
+          ]
+        },
+        "description": {
+          "type": "string"
+        },
+        "entity": {
+          "type": "string",
+          "enum": [
+            "policy",
+            "user",
+            "group"
+          ]
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "changes after a failed one are left pending, every change is pending on dry run",
+          "enum": [
+            "pending",
+            "applied",
+            "failed"
+          ]
+        }
+      }
+    },
+    "iamSnapshotGroup": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamSnapshotImportRequest": {
+      "type": "object",
+      "required": [
+        "snapshot"
+      ],
+      "properties": {
+        "dryRun": {
+          "type": "boolean"
+        },
+        "generateSecrets": {
+          "type": "boolean",
+          "title": "snapshots have no secret keys, users missing on the server are created with a generated one if set and fail otherwise"
+        },
+        "snapshot": {
+          "$ref": "#/definitions/iamSnapshot"
+        },
+        "strategy": {
+          "type": "string",
+          "title": "merge keeps entities missing on the snapshot and the policies of users and groups without any, replace removes them",
+          "enum": [
+            "merge",
+            "replace"
+          ]
+        }
+      }
+    },
+    "iamSnapshotImportResponse": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamSnapshotChange"
+          }
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "secretsExpiresAt": {
+          "type": "string"
+        },
+        "secretsToken": {
+          "type": "string",
+          "title": "token to download once the generated secret keys, the same way as the ones of a users import"
+        },
+        "strategy": {
+          "type": "string"
+        }
+      }
+    },
+    "iamSnapshotPolicy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "iamSnapshotUser": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string",
+          "title": "never exported, only required to create users missing on import"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "idpConfiguration": {
+      "type": "object",
+      "properties": {
+        "active_directory": {
+          "type": "object",
+          "required": [
+            "url",
+            "username_format",
+            "user_search_filter"
+          ],
+          "properties": {
+            "group_name_attribute": {
+              "type": "string"
+            },
+            "group_search_base_dn": {
+              "type": "string"
+            },
+            "group_search_filter": {
+              "type": "string"
+            },
+            "server_insecure": {
+              "type": "boolean"
+            },
+            "skip_tls_verification": {
+              "type": "boolean"
+            },
+            "url": {
+              "type": "string"
+            },
+            "user_search_filter": {
+              "type": "string"
+            },
+            "username_format": {
+              "type": "string"
+            }
+          }
+        },
+        "oidc": {
+          "type": "object",
+          "required": [
+            "url",
+            "client_id",
+            "secret_id"
+          ],
+          "properties": {
+            "client_id": {
+              "type": "string"
+            },
+            "secret_id": {
+              "type": "string"
+            },
+            "url": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "imageRegistry": {
+      "type": "object",
+      "required": [
+        "registry",
+        "username",
+        "password"
+      ],
+      "properties": {
+        "password": {
+          "type": "string"
+        },
+        "registry": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "importUserResult": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "line": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "secretGenerated": {
+          "type": "boolean"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "created",
+            "failed",
+            "invalid",
+            "skipped"
+          ]
+        }
+      }
+    },
+    "importUsersRequest": {
+      "type": "object",
+      "required": [
+        "csv"
+      ],
+      "properties": {
+        "concurrency": {
+          "type": "integer",
+          "format": "int32",
+          "title": "number of users created at the same time"
+        },
+        "csv": {
+          "type": "string",
+          "title": "CSV with a header row, columns are accessKey, secretKey, generateSecret, groups, policy and status, a secret is generated for rows without secretKey"
+        }
+      }
+    },
+    "importUsersResponse": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "invalid": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/importUserResult"
+          }
+        },
+        "secretsExpiresAt": {
+          "type": "string"
+        },
+        "secretsToken": {
+          "type": "string",
+          "title": "token to download once the generated secret keys"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "keyPairConfiguration": {
+      "type": "object",
+      "required": [
+        "crt",
+        "key"
+      ],
+      "properties": {
+        "crt": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "lifecycleRule": {
+      "type": "object",
+      "properties": {
+        "abort_incomplete_multipart_days": {
+          "type": "integer",
+          "format": "int64",
+          "title": "days after initiation when incomplete multipart uploads are aborted"
+        },
+        "expiration_date": {
+          "type": "string",
+          "title": "date when objects expire, formatted as YYYY-MM-DD"
+        },
+        "expiration_days": {
+          "type": "integer",
+          "format": "int64",
+          "title": "days after creation when objects expire"
+        },
+        "expired_object_delete_marker": {
+          "type": "boolean",
+          "title": "remove delete markers with no noncurrent versions left"
+        },
+        "id": {
+          "type": "string",
+          "title": "unique identifier of the rule, generated when empty"
+        },
+        "noncurrent_expiration_days": {
+          "type": "integer",
+          "format": "int64",
+          "title": "days after becoming noncurrent when versions expire"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "only objects under this prefix are affected"
+        },
+        "status": {
+          "type": "string",
+          "title": "defaults to Enabled",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "tags": {
+          "type": "array",
+          "title": "only objects with all these tags are affected",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        }
+      }
+    },
+    "lifecycleRuleErrors": {
+      "type": "object",
+      "required": [
+        "index"
+      ],
+      "properties": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int64",
+          "title": "position of the rule on the request"
+        }
+      }
+    },
+    "lifecycleTag": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "lifecycleValidationErrors": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "title": "validation errors of every invalid rule",
+          "items": {
+            "$ref": "#/definitions/lifecycleRuleErrors"
+          }
+        }
+      }
+    },
+    "listBucketEventsResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationConfig"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of bucket events"
+        }
+      }
+    },
+    "listBucketQuotasResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketQuotaUsage"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "title": "list of resulting buckets",
+          "items": {
+            "$ref": "#/definitions/bucket"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of buckets accessible to tenant user matching the search before pagination"
+        }
+      }
+    },
+    "listConfigResponse": {
+      "type": "object",
+      "properties": {
+        "configurations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configDescription"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of configurations"
+        }
+      }
+    },
+    "listGroupsResponse": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "title": "list of groups",
+          "items": {
+            "type": "string"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of groups"
+        }
+      }
+    },
+    "listObjectVersionsResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of versions"
+        },
+        "versions": {
+          "type": "array",
+          "title": "versions of the object, newest first",
+          "items": {
+            "$ref": "#/definitions/objectVersion"
+          }
+        }
+      }
+    },
+    "listObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "is_truncated": {
+          "type": "boolean",
+          "title": "true when there are more results to fetch"
+        },
+        "next_continuation_token": {
+          "type": "string",
+          "title": "token to send on the next request to fetch the following page"
+        },
+        "objects": {
+          "type": "array",
+          "title": "list of resulting objects",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of objects and prefixes in this page"
+        }
+      }
+    },
+    "listPoliciesResponse": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/policy"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of policies"
+        }
+      }
+    },
+    "listRemoteBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "title": "list of remote bucket targets",
+          "items": {
+            "$ref": "#/definitions/remoteBucket"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of remote bucket targets"
+        }
+      }
+    },
+    "listSharesResponse": {
+      "type": "object",
+      "properties": {
+        "shares": {
+          "type": "array",
+          "title": "list of links that haven't expired",
+          "items": {
+            "$ref": "#/definitions/shareLink"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of active links"
+        }
+      }
+    },
+    "listTenantsResponse": {
+      "type": "object",
+      "properties": {
+        "tenants": {
+          "type": "array",
+          "title": "list of resulting tenants",
+          "items": {
+            "$ref": "#/definitions/tenantList"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of tenants accessible to tenant user"
+        }
+      }
+    },
+    "listUsersResponse": {
+      "type": "object",
+      "properties": {
+        "users": {
+          "type": "array",
+          "title": "list of resulting users",
+          "items": {
+            "$ref": "#/definitions/user"
+          }
+        }
+      }
+    },
+    "loginDetails": {
+      "type": "object",
+      "properties": {
+        "loginStrategy": {
+          "type": "string",
+          "enum": [
+            "form",
+            "redirect",
+            "service-account"
+          ]
+        },
+        "redirect": {
+          "type": "string"
+        }
+      }
+    },
+    "loginOauth2AuthRequest": {
+      "type": "object",
+      "required": [
+        "state",
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        }
+      }
+    },
+    "loginOperatorRequest": {
+      "type": "object",
+      "required": [
+        "jwt"
+      ],
+      "properties": {
+        "jwt": {
+          "type": "string"
+        }
+      }
+    },
+    "loginRequest": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "loginResponse": {
+      "type": "object",
+      "properties": {
+        "sessionId": {
+          "type": "string"
+        }
+      }
+    },
+    "makeBucketRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "encryption": {
+          "$ref": "#/definitions/bucketEncryption"
+        },
+        "name": {
+          "type": "string"
+        },
+        "object_locking": {
+          "type": "boolean"
+        },
+        "quota": {
+          "$ref": "#/definitions/bucketQuota"
+        },
+        "retention": {
+          "$ref": "#/definitions/bucketRetention"
+        }
+      }
+    },
+    "nodeSelectorTerm": {
+      "description": "A null or empty node selector term matches no objects. The requirements of them are ANDed. The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.",
+      "type": "object",
+      "properties": {
+        "matchExpressions": {
+          "description": "A list of node selector requirements by node's labels.",
+          "type": "array",
+          "items": {
+            "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+            "type": "object",
+            "required": [
+              "key",
+              "operator"
+            ],
+            "properties": {
+              "key": {
+                "description": "The label key that the selector applies to.",
+                "type": "string"
+              },
+              "operator": {
+                "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+                "type": "string"
+              },
+              "values": {
+                "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+                "type": "array",
+                "items": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        },
+        "matchFields": {
+          "description": "A list of node selector requirements by node's fields.",
+          "type": "array",
+          "items": {
+            "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+            "type": "object",
+            "required": [
+              "key",
+              "operator"
+            ],
+            "properties": {
+              "key": {
+                "description": "The label key that the selector applies to.",
+                "type": "string"
+              },
+              "operator": {
+                "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+                "type": "string"
+              },
+              "values": {
+                "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+                "type": "array",
+                "items": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "nofiticationService": {
+      "type": "string",
+      "enum": [
+        "webhook",
+        "amqp",
+        "kafka",
+        "mqtt",
+        "nats",
+        "nsq",
+        "mysql",
+        "postgres",
+        "elasticsearch",
+        "redis"
+      ]
+    },
+    "notifEndpointResponse": {
+      "type": "object",
+      "properties": {
+        "notification_endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationEndpointItem"
+          }
+        }
+      }
+    },
+    "notificationConfig": {
+      "type": "object",
+      "required": [
+        "arn"
+      ],
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
+        }
+      }
+    },
+    "notificationDeleteRequest": {
+      "type": "object",
+      "required": [
+        "events",
+        "prefix",
+        "suffix"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
+        }
+      }
+    },
+    "notificationEndpoint": {
+      "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        }
+      }
+    },
+    "notificationEndpointItem": {
+      "type": "object",
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "notificationEventType": {
+      "type": "string",
+      "enum": [
+        "put",
+        "delete",
+        "get"
+      ]
+    },
+    "notificationTestResult": {
+      "type": "object",
+      "properties": {
+        "events_published": {
+          "type": "array",
+          "title": "events of the probe object seen on the MinIO listen API, they don't prove the target received them and events published before the listener attached are missed",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "events_sent": {
+          "type": "array",
+          "title": "events triggered on the probe object",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "object": {
+          "type": "string",
+          "title": "name of the probe object"
+        },
+        "success": {
+          "type": "boolean",
+          "title": "every event was seen on the listen API and the target is online"
+        },
+        "target_status": {
+          "type": "string",
+          "title": "status of the target reported by MinIO",
+          "enum": [
+            "online",
+            "offline",
+            "unknown"
+          ]
+        }
+      }
+    },
+    "notificationUpdateRequest": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
+        }
+      }
+    },
+    "objectMetadata": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "headers": {
+          "type": "object",
+          "title": "system headers of the object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold": {
+          "type": "string",
+          "title": "legal hold status, ON or OFF, empty if it was never set"
+        },
+        "name": {
+          "type": "string"
+        },
+        "retain_until": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_metadata": {
+          "type": "object",
+          "title": "user-defined metadata without the x-amz-meta- prefix",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "objectPreview": {
+      "type": "object",
+      "properties": {
+        "content": {
+          "type": "string",
+          "title": "beginning of the object for text and json objects"
+        },
+        "content_type": {
+          "type": "string",
+          "title": "content type stored along with the object"
+        },
+        "detected_content_type": {
+          "type": "string",
+          "title": "content type detected from the object data"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "text",
+            "json",
+            "image",
+            "binary"
+          ]
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean",
+          "title": "set when content doesn't hold the whole object"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "objectTags": {
+      "type": "object",
+      "properties": {
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "objectVersion": {
+      "type": "object",
+      "properties": {
+        "etag": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "podAffinityTerm": {
+      "description": "Required. A pod affinity term, associated with the corresponding weight.",
+      "type": "object",
+      "required": [
+        "topologyKey"
+      ],
+      "properties": {
+        "labelSelector": {
+          "description": "A label query over a set of resources, in this case pods.",
+          "type": "object",
+          "properties": {
+            "matchExpressions": {
+              "description": "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
+              "type": "array",
+              "items": {
+                "description": "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+                "type": "object",
+                "required": [
+                  "key",
+                  "operator"
+                ],
+                "properties": {
+                  "key": {
+                    "description": "key is the label key that the selector applies to.",
+                    "type": "string"
+                  },
+                  "operator": {
+                    "description": "operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.",
+                    "type": "string"
+                  },
+                  "values": {
+                    "description": "values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.",
+                    "type": "array",
+                    "items": {
+                      "type": "string"
+                    }
+                  }
+                }
+              }
+            },
+            "matchLabels": {
+              "description": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            }
+          }
+        },
+        "namespaces": {
+          "description": "namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means \"this pod's namespace\"",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "topologyKey": {
+          "description": "This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.",
+          "type": "string"
+        }
+      }
+    },
+    "policy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "policyEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group"
+      ]
+    },
+    "policySimulationRequest": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityName",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "title": "action to evaluate, e.g. s3:GetObject"
+        },
+        "conditions": {
+          "type": "object",
+          "title": "condition values such as aws:SourceIp",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount"
+          ]
+        },
+        "parentUser": {
+          "type": "string",
+          "title": "user owning the service account, required for service accounts and must be the logged in user"
+        },
+        "resource": {
+          "type": "string",
+          "title": "resource ARN, e.g. arn:aws:s3:::bucket/object, or bucket/object"
+        },
+        "sessionPolicy": {
+          "type": "string",
+          "title": "policy the service account was created with, MinIO doesn't return it so it must be provided"
+        }
+      }
+    },
+    "policySimulationResponse": {
+      "type": "object",
+      "properties": {
+        "decision": {
+          "type": "string",
+          "enum": [
+            "allow",
+            "deny"
+          ]
+        },
+        "matchedStatements": {
+          "type": "array",
+          "title": "statements that decided the result, empty when nothing allows the action",
+          "items": {
+            "$ref": "#/definitions/policyStatementMatch"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "title": "policies evaluated",
+          "items": {
+            "type": "string"
+          }
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "policySource": {
+      "type": "object",
+      "properties": {
+        "group": {
+          "type": "string",
+          "title": "group the policy is set on, empty if it's set on the user"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "policyStatementMatch": {
+      "type": "object",
+      "properties": {
+        "effect": {
+          "type": "string"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int64",
+          "title": "position of the statement in the policy, starting at 0"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "statement": {
+          "type": "string"
+        }
+      }
+    },
+    "principal": {
+      "type": "object",
+      "properties": {
+        "accessKeyID": {
+          "type": "string"
+        },
+        "actions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretAccessKey": {
+          "type": "string"
+        },
+        "sessionToken": {
+          "type": "string"
+        }
+      }
+    },
+    "profilerType": {
+      "type": "string",
+      "enum": [
+        "cpu",
+        "mem",
+        "block",
+        "mutex",
+        "trace",
+        "threads",
+        "goroutines"
+      ]
+    },
+    "profilingStartRequest": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "type": {
+          "$ref": "#/definitions/profilerType"
+        }
+      }
+    },
+    "putObjectLegalHoldRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "enabled",
+            "disabled"
+          ]
+        }
+      }
+    },
+    "putObjectRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "retain_until"
+      ],
+      "properties": {
+        "governance_bypass": {
+          "type": "boolean",
+          "title": "allows to shorten or remove a governance retention"
+        },
+        "mode": {
+          "type": "string",
+          "enum": [
+            "governance",
+            "compliance"
+          ]
+        },
+        "retain_until": {
+          "type": "string",
+          "title": "RFC3339 date until the object is retained"
+        }
+      }
+    },
+    "remoteBucket": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "arn": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secure": {
+          "type": "boolean"
+        },
+        "service": {
+          "type": "string"
+        },
+        "source_bucket": {
+          "type": "string"
+        },
+        "target_bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "resourceQuota": {
+      "type": "object",
+      "properties": {
+        "elements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resourceQuotaElement"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "resourceQuotaElement": {
+      "type": "object",
+      "properties": {
+        "hard": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "selectInputSerialization": {
+      "type": "object",
+      "required": [
+        "format"
+      ],
+      "properties": {
+        "compression": {
+          "type": "string",
+          "enum": [
+            "none",
+            "gzip",
+            "bzip2"
+          ]
+        },
+        "csv_comments": {
+          "type": "string",
+          "title": "lines starting with this character are skipped"
+        },
+        "csv_field_delimiter": {
+          "type": "string"
+        },
+        "csv_header": {
+          "type": "string",
+          "title": "how the first line of a CSV object is handled, use if not set",
+          "enum": [
+            "use",
+            "ignore",
+            "none"
+          ]
+        },
+        "csv_quote_character": {
+          "type": "string"
+        },
+        "format": {
+          "type": "string",
+          "enum": [
+            "csv",
+            "json",
+            "parquet"
+          ]
+        },
+        "json_type": {
+          "type": "string",
+          "title": "lines if not set",
+          "enum": [
+            "lines",
+            "document"
+          ]
+        }
+      }
+    },
+    "selectObjectEvent": {
+      "type": "object",
+      "properties": {
+        "bytes_processed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytes_returned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytes_scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "type": "string"
+        },
+        "records": {
+          "type": "string"
+        },
+        "truncated": {
+          "type": "boolean",
+          "title": "set on the stats event when the row limit was reached"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "records",
+            "progress",
+            "stats",
+            "error"
+          ]
+        }
+      }
+    },
+    "selectObjectRequest": {
+      "type": "object",
+      "required": [
+        "expression",
+        "input"
+      ],
+      "properties": {
+        "expression": {
+          "type": "string",
+          "title": "SQL expression, the object is referenced as S3Object"
+        },
+        "input": {
+          "$ref": "#/definitions/selectInputSerialization"
+        },
+        "output_format": {
+          "type": "string",
+          "title": "format of the returned records, json if not set",
+          "enum": [
+            "json",
+            "csv"
+          ]
+        },
+        "request_progress": {
+          "type": "boolean",
+          "title": "sends progress events while the object is scanned"
+        },
+        "row_limit": {
+          "type": "integer",
+          "format": "int64",
+          "title": "maximum number of records returned, 1000 if not set",
+          "minimum": 0
+        }
+      }
+    },
+    "serviceAccountCreds": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountRequest": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "type": "string",
+          "title": "policy to be applied to the Service Account if any"
+        }
+      }
+    },
+    "serviceAccounts": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
+    "sessionResponse": {
+      "type": "object",
+      "properties": {
+        "pages": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "ok"
+          ]
+        }
+      }
+    },
+    "setBucketLifecycleRequest": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleRule"
+          }
+        }
+      }
+    },
+    "setBucketPolicyRequest": {
+      "type": "object",
+      "required": [
+        "access"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        }
+      }
+    },
+    "setBucketVersioningRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Suspended"
+          ]
+        }
+      }
+    },
+    "setConfigRequest": {
+      "type": "object",
+      "required": [
+        "key_values"
+      ],
+      "properties": {
+        "arn_resource_id": {
+          "type": "string",
+          "title": "Used if configuration is an event notification's target"
+        },
+        "key_values": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        }
+      }
+    },
+    "setPolicyRequest": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityName"
+      ],
+      "properties": {
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "$ref": "#/definitions/policyEntity"
+        },
+        "mode": {
+          "type": "string",
+          "title": "replace attaches only the given policies, add and remove keep the rest of the attached policies",
+          "enum": [
+            "replace",
+            "add",
+            "remove"
+          ]
+        },
+        "policies": {
+          "type": "array",
+          "title": "policies set along with the ones named on the path",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "shareLink": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string"
+        },
+        "created_by": {
+          "type": "string",
+          "title": "access key of the session that generated the link"
+        },
+        "expires_at": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "object": {
+          "type": "string"
+        }
+      }
+    },
+    "shareObjectRequest": {
+      "type": "object",
+      "required": [
+        "prefix"
+      ],
+      "properties": {
+        "expires": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds until the link expires, defaults to 7 days",
+          "maximum": 604800,
+          "minimum": 1
+        },
+        "method": {
+          "type": "string",
+          "title": "GET links download the object, PUT links upload it, defaults to GET",
+          "enum": [
+            "GET",
+            "PUT"
+          ]
+        },
+        "prefix": {
+          "type": "string",
+          "title": "name of the object to share"
+        },
+        "response_headers": {
+          "type": "object",
+          "title": "response headers overridden on GET links e.g. content-disposition",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "shareObjectResponse": {
+      "type": "object",
+      "properties": {
+        "expires_at": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "startProfilingItem": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "nodeName": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "startProfilingList": {
+      "type": "object",
+      "properties": {
+        "startResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/startProfilingItem"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of start results"
+        }
+      }
+    },
+    "tenant": {
+      "type": "object",
+      "properties": {
+        "creation_date": {
+          "type": "string"
+        },
+        "currentState": {
+          "type": "string"
+        },
+        "image": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "total_size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "zones": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/zone"
+          }
+        }
+      }
+    },
+    "tenantList": {
+      "type": "object",
+      "properties": {
+        "creation_date": {
+          "type": "string"
+        },
+        "currentState": {
+          "type": "string"
+        },
+        "instance_count": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "total_size": {
+          "type": "integer"
+        },
+        "volume_count": {
+          "type": "integer"
+        },
+        "zone_count": {
+          "type": "integer"
+        }
+      }
+    },
+    "tenantUsage": {
+      "type": "object",
+      "properties": {
+        "disk_used": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "tlsConfiguration": {
+      "type": "object",
+      "properties": {
+        "console": {
           "type": "object",
-          "$ref": "#/definitions/encryptionConfiguration"
+          "$ref": "#/definitions/keyPairConfiguration"
+        },
+        "minio": {
+          "type": "object",
+          "$ref": "#/definitions/keyPairConfiguration"
+        }
+      }
+    },
+    "updateGroupRequest": {
+      "type": "object",
+      "required": [
+        "members",
+        "status"
+      ],
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "updateTenantRequest": {
+      "type": "object",
+      "properties": {
+        "console_image": {
+          "type": "string",
+          "pattern": "^((.*?)/(.*?):(.+))$"
+        },
+        "image": {
+          "type": "string",
+          "pattern": "^((.*?)/(.*?):(.+))$"
+        },
+        "image_pull_secret": {
+          "type": "string"
+        },
+        "image_registry": {
+          "$ref": "#/definitions/imageRegistry"
+        }
+      }
+    },
+    "updateUser": {
+      "type": "object",
+      "required": [
+        "status",
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "updateUserGroups": {
+      "type": "object",
+      "required": [
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "uploadObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of files that couldn't be uploaded"
+        },
+        "objects": {
+          "type": "array",
+          "title": "result of every file sent on the request",
+          "items": {
+            "$ref": "#/definitions/uploadedObject"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of files received"
+        }
+      }
+    },
+    "uploadedObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string",
+          "title": "reason why the object couldn't be uploaded, empty on success"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "user": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "memberOf": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "array",
+          "title": "policies attached to the user",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "userEffectivePolicy": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/effectivePolicyGroup"
+          }
         },
-        "erasureCodingParity": {
-          "type": "integer"
+        "policies": {
+          "type": "array",
+          "title": "policies applying to the user",
+          "items": {
+            "type": "string"
+          }
         },
-        "idp": {
-          "type": "object",
-          "$ref": "#/definitions/idpConfiguration"
+        "policy": {
+          "type": "string",
+          "title": "effective policy merging every policy applying to the user"
         },
-        "image": {
-          "type": "string"
+        "statements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/effectivePolicyStatement"
+          }
         },
-        "image_pull_secret": {
+        "status": {
           "type": "string"
+        }
+      }
+    },
+    "vaultConfiguration": {
+      "type": "object",
+      "required": [
+        "endpoint",
+        "approle"
+      ],
+      "properties": {
+        "approle": {
+          "type": "object",
+          "required": [
+            "id",
+            "secret"
+          ],
+          "properties": {
+            "engine": {
+              "type": "string"
+            },
+            "id": {
+              "type": "string"
+            },
+            "retry": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "secret": {
+              "type": "string"
+            }
+          }
         },
-        "image_registry": {
-          "$ref": "#/definitions/imageRegistry"
-        },
-        "mounth_path": {
+        "endpoint": {
           "type": "string"
         },
-        "name": {
-          "type": "string",
-          "pattern": "^[a-z0-9-]{3,63}$"
+        "engine": {
+          "type": "string"
         },
         "namespace": {
           "type": "string"
         },
-        "secret_key": {
+        "prefix": {
           "type": "string"
         },
-        "service_name": {
-          "type": "string"
+        "status": {
+          "type": "object",
+          "properties": {
+            "ping": {
+              "type": "integer",
+              "format": "int64"
+            }
+          }
         },
         "tls": {
           "type": "object",
-          "$ref": "#/definitions/tlsConfiguration"
-        },
-        "zones": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/zone"
+          "properties": {
+            "ca": {
+              "type": "string"
+            },
+            "crt": {
+              "type": "string"
+            },
+            "key": {
+              "type": "string"
+            }
           }
         }
       }
     },
-    "createTenantResponse": {
+    "zone": {
       "type": "object",
+      "required": [
+        "servers",
+        "volumes_per_server",
+        "volume_configuration"
+      ],
       "properties": {
-        "access_key": {
+        "affinity": {
+          "$ref": "#/definitions/zoneAffinity"
+        },
+        "name": {
           "type": "string"
         },
-        "console": {
+        "node_selector": {
+          "description": "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "resources": {
+          "$ref": "#/definitions/zoneResources"
+        },
+        "servers": {
+          "type": "integer"
+        },
+        "tolerations": {
+          "$ref": "#/definitions/zoneTolerations"
+        },
+        "volume_configuration": {
           "type": "object",
+          "required": [
+            "size"
+          ],
           "properties": {
-            "access_key": {
-              "type": "string"
+            "size": {
+              "type": "integer"
             },
-            "secret_key": {
+            "storage_class_name": {
               "type": "string"
             }
           }
         },
-        "secret_key": {
-          "type": "string"
+        "volumes_per_server": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "encryptionConfiguration": {
+    "zoneAffinity": {
+      "description": "If specified, affinity will define the pod's scheduling constraints",
+      "type": "object",
+      "properties": {
+        "nodeAffinity": {
+          "description": "Describes node affinity scheduling rules for the pod.",
+          "type": "object",
+          "properties": {
+            "preferredDuringSchedulingIgnoredDuringExecution": {
+              "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.",
+              "type": "array",
+              "items": {
+                "description": "An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).",
+                "type": "object",
+                "required": [
+                  "preference",
+                  "weight"
+                ],
+                "properties": {
+                  "preference": {
+                    "description": "A node selector term, associated with the corresponding weight.",
+                    "type": "object",
+                    "$ref": "#/definitions/nodeSelectorTerm"
+                  },
+                  "weight": {
+                    "description": "Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.",
+                    "type": "integer",
+                    "format": "int32"
+                  }
+                }
+              }
+            },
+            "requiredDuringSchedulingIgnoredDuringExecution": {
+              "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.",
+              "type": "object",
+              "required": [
+                "nodeSelectorTerms"
+              ],
+              "properties": {
+                "nodeSelectorTerms": {
+                  "description": "Required. A list of node selector terms. The terms are ORed.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/nodeSelectorTerm"
+                  }
+                }
+              }
+            }
+          }
+        },
+        "podAffinity": {
+          "description": "Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).",
+          "type": "object",
+          "properties": {
+            "preferredDuringSchedulingIgnoredDuringExecution": {
+              "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
+              "type": "array",
+              "items": {
+                "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
+                "type": "object",
+                "required": [
+                  "podAffinityTerm",
+                  "weight"
+                ],
+                "properties": {
+                  "podAffinityTerm": {
+                    "$ref": "#/definitions/podAffinityTerm"
+                  },
+                  "weight": {
+                    "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
+                    "type": "integer",
+                    "format": "int32"
+                  }
+                }
+              }
+            },
+            "requiredDuringSchedulingIgnoredDuringExecution": {
+              "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/podAffinityTerm"
+              }
+            }
+          }
+        },
+        "podAntiAffinity": {
+          "description": "Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).",
+          "type": "object",
+          "properties": {
+            "preferredDuringSchedulingIgnoredDuringExecution": {
+              "description": "The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
+              "type": "array",
+              "items": {
+                "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
+                "type": "object",
+                "required": [
+                  "podAffinityTerm",
+                  "weight"
+                ],
+                "properties": {
+                  "podAffinityTerm": {
+                    "$ref": "#/definitions/podAffinityTerm"
+                  },
+                  "weight": {
+                    "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
+                    "type": "integer",
+                    "format": "int32"
+                  }
+                }
+              }
+            },
+            "requiredDuringSchedulingIgnoredDuringExecution": {
+              "description": "If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/podAffinityTerm"
+              }
+            }
+          }
+        }
+      }
+    },
+    "zoneResources": {
+      "description": "If provided, use these requests and limit for cpu/memory resource allocation",
       "type": "object",
       "properties": {
-        "aws": {
-          "type": "object",
-          "$ref": "#/definitions/awsConfiguration"
-        },
-        "client": {
-          "type": "object",
-          "$ref": "#/definitions/keyPairConfiguration"
-        },
-        "gemalto": {
-          "type": "object",
-          "$ref": "#/definitions/gemaltoConfiguration"
-        },
-        "image": {
-          "type": "string"
-        },
-        "server": {
+        "limits": {
+          "description": "Limits describes the maximum amount of compute resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/",
           "type": "object",
-          "$ref": "#/definitions/keyPairConfiguration"
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
         },
-        "vault": {
+        "requests": {
+          "description": "Requests describes the minimum amount of compute resources required. If Requests is omitted for a container, it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/",
           "type": "object",
-          "$ref": "#/definitions/vaultConfiguration"
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
         }
       }
     },
-    "error": {
+    "zoneTolerationSeconds": {
+      "description": "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
       "type": "object",
       "required": [
-        "message"
+        "seconds"
       ],
       "properties": {
-        "code": {
+        "seconds": {
           "type": "integer",
           "format": "int64"
-        },
-        "message": {
-          "type": "string"
         }
       }
     },
-    "gemaltoConfiguration": {
-      "type": "object",
-      "required": [
-        "keysecure"
-      ],
-      "properties": {
-        "keysecure": {
-          "type": "object",
-          "required": [
-            "endpoint",
-            "credentials"
-          ],
-          "properties": {
-            "credentials": {
-              "type": "object",
-              "required": [
-                "token",
-                "domain"
-              ],
-              "properties": {
-                "domain": {
-                  "type": "string"
-                },
-                "retry": {
-                  "type": "integer",
-                  "format": "int64"
-                },
-                "token": {
-                  "type": "string"
-                }
-              }
-            },
-            "endpoint": {
-              "type": "string"
-            },
-            "tls": {
-              "type": "object",
-              "required": [
-                "ca"
-              ],
-              "properties": {
-                "ca": {
-                  "type": "string"
-                }
-              }
+    "zoneTolerations": {
+      "description": "Tolerations allows users to set entries like effect, key, operator, value.",
+      "type": "array",
+      "items": {
+        "description": "The pod this Toleration is attached to tolerates any taint that matches the triple \u003ckey,value,effect\u003e using the matching operator \u003coperator\u003e.",
+        "type": "object",
+        "properties": {
+          "effect": {
+            "description": "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+            "type": "string"
+          },
+          "key": {
+            "description": "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
+            "type": "string"
+          },
+          "operator": {
+            "description": "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
+            "type": "string"
+          },
+          "tolerationSeconds": {
+            "$ref": "#/definitions/zoneTolerationSeconds"
+          },
+          "value": {
+            "description": "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
+            "type": "string"
+          }
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "key": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://min.io",
+      "tokenUrl": "http://min.io"
+    }
+  },
+  "security": [
+    {
+      "key": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "ws"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "MinIO Console Server",
+    "version": "0.1.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/admin/arns": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns a list of active ARNs in the instance",
+        "operationId": "ArnList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/arnsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/bucket-quotas": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns the quota and usage of every bucket",
+        "operationId": "ListBucketQuotas",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketQuotasResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns information about the deployment",
+        "operationId": "AdminInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/adminInfoResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/notification_endpoints": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns a list of active notification endpoints",
+        "operationId": "NotificationEndpointList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notifEndpointResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Allows to configure a new notification endpoint",
+        "operationId": "AddNotificationEndpoint",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationEndpoint"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notificationEndpoint"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "group": {
-      "type": "object",
-      "properties": {
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/admin/remote-buckets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns the remote buckets registered as targets of local buckets",
+        "operationId": "ListRemoteBuckets",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "only list the targets of this source bucket",
+            "name": "bucket_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listRemoteBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
         }
-      }
-    },
-    "idpConfiguration": {
-      "type": "object",
-      "properties": {
-        "active_directory": {
-          "type": "object",
-          "required": [
-            "url",
-            "username_format",
-            "user_search_filter"
-          ],
-          "properties": {
-            "group_name_attribute": {
-              "type": "string"
-            },
-            "group_search_base_dn": {
-              "type": "string"
-            },
-            "group_search_filter": {
-              "type": "string"
-            },
-            "server_insecure": {
-              "type": "boolean"
-            },
-            "skip_tls_verification": {
-              "type": "boolean"
-            },
-            "url": {
-              "type": "string"
-            },
-            "user_search_filter": {
-              "type": "string"
-            },
-            "username_format": {
-              "type": "string"
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Registers a remote bucket as a replication target of a local bucket",
+        "operationId": "AddRemoteBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRemoteBucket"
             }
           }
-        },
-        "oidc": {
-          "type": "object",
-          "required": [
-            "url",
-            "client_id",
-            "secret_id"
-          ],
-          "properties": {
-            "client_id": {
-              "type": "string"
-            },
-            "secret_id": {
-              "type": "string"
-            },
-            "url": {
-              "type": "string"
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/remoteBucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "imageRegistry": {
-      "type": "object",
-      "required": [
-        "registry",
-        "username",
-        "password"
-      ],
-      "properties": {
-        "password": {
-          "type": "string"
-        },
-        "registry": {
-          "type": "string"
-        },
-        "username": {
-          "type": "string"
-        }
-      }
-    },
-    "keyPairConfiguration": {
-      "type": "object",
-      "required": [
-        "crt",
-        "key"
-      ],
-      "properties": {
-        "crt": {
-          "type": "string"
-        },
-        "key": {
-          "type": "string"
-        }
-      }
-    },
-    "listBucketEventsResponse": {
-      "type": "object",
-      "properties": {
-        "events": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationConfig"
+    "/admin/remote-buckets/{bucket_name}/{arn}": {
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Removes a remote bucket target",
+        "operationId": "DeleteRemoteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of bucket events"
         }
       }
     },
-    "listBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of resulting buckets",
-          "items": {
-            "$ref": "#/definitions/bucket"
+    "/buckets": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "only returns buckets whose name contains this text, case insensitive",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only returns buckets whose name starts with this prefix",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "name, size or creation_date, buckets are sorted by name if not set",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "asc or desc, asc if not set",
+            "name": "sort_order",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "number of matching buckets to skip",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "maximum number of buckets to return, every matching bucket if not set",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only returns buckets having this tag",
+            "name": "tag_key",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "value the tag set on tag_key must have, any value if not set",
+            "name": "tag_value",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "returns the buckets along with their tags, always done when tag_key is set",
+            "name": "include_tags",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of buckets accessible to tenant user"
         }
-      }
-    },
-    "listConfigResponse": {
-      "type": "object",
-      "properties": {
-        "configurations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configDescription"
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/makeBucketRequest"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of configurations"
-        }
-      }
-    },
-    "listGroupsResponse": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "title": "list of groups",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of groups"
         }
       }
     },
-    "listPoliciesResponse": {
-      "type": "object",
-      "properties": {
-        "policies": {
-          "type": "array",
-          "title": "list of policies",
-          "items": {
-            "$ref": "#/definitions/policy"
+    "/buckets/{bucket_name}/encryption": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets the default server-side encryption of a bucket",
+        "operationId": "SetBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEncryption"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
-        }
-      }
-    },
-    "listTenantsResponse": {
-      "type": "object",
-      "properties": {
-        "tenants": {
-          "type": "array",
-          "title": "list of resulting tenants",
-          "items": {
-            "$ref": "#/definitions/tenantList"
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of tenants accessible to tenant user"
         }
-      }
-    },
-    "listUsersResponse": {
-      "type": "object",
-      "properties": {
-        "users": {
-          "type": "array",
-          "title": "list of resulting users",
-          "items": {
-            "$ref": "#/definitions/user"
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Removes the default server-side encryption of a bucket",
+        "operationId": "DisableBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "loginDetails": {
-      "type": "object",
-      "properties": {
-        "loginStrategy": {
-          "type": "string",
-          "enum": [
-            "form",
-            "redirect",
-            "service-account"
-          ]
-        },
-        "redirect": {
-          "type": "string"
-        }
-      }
-    },
-    "loginOauth2AuthRequest": {
-      "type": "object",
-      "required": [
-        "state",
-        "code"
-      ],
-      "properties": {
-        "code": {
-          "type": "string"
-        },
-        "state": {
-          "type": "string"
+    "/buckets/{bucket_name}/events": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List Bucket Events",
+        "operationId": "ListBucketEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketEventsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "loginOperatorRequest": {
-      "type": "object",
-      "required": [
-        "jwt"
-      ],
-      "properties": {
-        "jwt": {
-          "type": "string"
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Create Bucket Event",
+        "operationId": "CreateBucketEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEventRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "loginRequest": {
-      "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string"
+    "/buckets/{bucket_name}/events/{arn}": {
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Bucket Event",
+        "operationId": "DeleteBucketEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationDeleteRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "loginResponse": {
-      "type": "object",
-      "properties": {
-        "sessionId": {
-          "type": "string"
+    "/buckets/{bucket_name}/events/{arn}/{id}": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Replaces the events, prefix and suffix of a bucket notification rule",
+        "operationId": "UpdateBucketEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notificationConfig"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "makeBucketRequest": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "name": {
-          "type": "string"
+    "/buckets/{bucket_name}/events/{arn}/{id}/test": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Writes and deletes a probe object matching a notification rule and reports the events sent for it",
+        "operationId": "TestBucketEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notificationTestResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "nodeSelectorTerm": {
-      "description": "A null or empty node selector term matches no objects. The requirements of them are ANDed. The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.",
-      "type": "object",
-      "properties": {
-        "matchExpressions": {
-          "description": "A list of node selector requirements by node's labels.",
-          "type": "array",
-          "items": {
-            "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
-            "type": "object",
-            "required": [
-              "key",
-              "operator"
-            ],
-            "properties": {
-              "key": {
-                "description": "The label key that the selector applies to.",
-                "type": "string"
-              },
-              "operator": {
-                "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
-                "type": "string"
-              },
-              "values": {
-                "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
-                "type": "array",
-                "items": {
-                  "type": "string"
-                }
-              }
+    "/buckets/{bucket_name}/lifecycle": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get the lifecycle rules of a bucket",
+        "operationId": "GetBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycleResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Replace every lifecycle rule of a bucket",
+        "operationId": "SetBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketLifecycleRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycleResponse"
+            }
+          },
+          "400": {
+            "description": "One or more rules are not valid.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleValidationErrors"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Add a lifecycle rule to a bucket",
+        "operationId": "AddBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
             }
           }
-        },
-        "matchFields": {
-          "description": "A list of node selector requirements by node's fields.",
-          "type": "array",
-          "items": {
-            "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
-            "type": "object",
-            "required": [
-              "key",
-              "operator"
-            ],
-            "properties": {
-              "key": {
-                "description": "The label key that the selector applies to.",
-                "type": "string"
-              },
-              "operator": {
-                "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
-                "type": "string"
-              },
-              "values": {
-                "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
-                "type": "array",
-                "items": {
-                  "type": "string"
-                }
-              }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
+            }
+          },
+          "400": {
+            "description": "The rule is not valid.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleValidationErrors"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "nofiticationService": {
-      "type": "string",
-      "enum": [
-        "webhook",
-        "amqp",
-        "kafka",
-        "mqtt",
-        "nats",
-        "nsq",
-        "mysql",
-        "postgres",
-        "elasticsearch",
-        "redis"
-      ]
-    },
-    "notifEndpointResponse": {
-      "type": "object",
-      "properties": {
-        "notification_endpoints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationEndpointItem"
+    "/buckets/{bucket_name}/lifecycle/{rule_id}": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Update a lifecycle rule of a bucket",
+        "operationId": "UpdateBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
+            }
+          },
+          "400": {
+            "description": "The rule is not valid.",
+            "schema": {
+              "$ref": "#/definitions/lifecycleValidationErrors"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "notificationConfig": {
-      "type": "object",
-      "required": [
-        "arn"
-      ],
-      "properties": {
-        "arn": {
-          "type": "string"
-        },
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete a lifecycle rule of a bucket",
+        "operationId": "DeleteBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
-        },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "notificationDeleteRequest": {
-      "type": "object",
-      "required": [
-        "events",
-        "prefix",
-        "suffix"
-      ],
-      "properties": {
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "minLength": 1,
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
+    "/buckets/{bucket_name}/objects": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List Objects",
+        "operationId": "ListObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "delimiter",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "continuation_token",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "max_keys",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
-        },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "notificationEndpoint": {
-      "type": "object",
-      "required": [
-        "service",
-        "account_id",
-        "properties"
-      ],
-      "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/buckets/{bucket_name}/objects/bulk": {
+      "post": {
+        "description": "The manifest is a CSV with a key and an optional version id per line, or a JSON array of objects with key and version_id. The result of every entry is reported in manifest order.",
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Applies an action to every object of a manifest",
+        "operationId": "BulkObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkObjectsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bulkObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "notificationEndpointItem": {
-      "type": "object",
-      "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
-        },
-        "status": {
-          "type": "string"
+    "/buckets/{bucket_name}/objects/copy": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Starts a server-side copy or move of an object or a prefix",
+        "operationId": "CopyObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/copyObjectsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/copyOperation"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "notificationEventType": {
-      "type": "string",
-      "enum": [
-        "put",
-        "delete",
-        "get"
-      ]
-    },
-    "podAffinityTerm": {
-      "description": "Required. A pod affinity term, associated with the corresponding weight.",
-      "type": "object",
-      "required": [
-        "topologyKey"
-      ],
-      "properties": {
-        "labelSelector": {
-          "description": "A label query over a set of resources, in this case pods.",
-          "type": "object",
-          "properties": {
-            "matchExpressions": {
-              "description": "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
-              "type": "array",
-              "items": {
-                "description": "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
-                "type": "object",
-                "required": [
-                  "key",
-                  "operator"
-                ],
-                "properties": {
-                  "key": {
-                    "description": "key is the label key that the selector applies to.",
-                    "type": "string"
-                  },
-                  "operator": {
-                    "description": "operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.",
-                    "type": "string"
-                  },
-                  "values": {
-                    "description": "values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.",
-                    "type": "array",
-                    "items": {
-                      "type": "string"
-                    }
-                  }
-                }
-              }
-            },
-            "matchLabels": {
-              "description": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
-              "type": "object",
-              "additionalProperties": {
-                "type": "string"
-              }
-            }
+    "/buckets/{bucket_name}/objects/copy/{operation_id}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns the progress of a copy or move operation",
+        "operationId": "GetCopyOperation",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "operation_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "namespaces": {
-          "description": "namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means \"this pod's namespace\"",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/copyOperation"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "topologyKey": {
-          "description": "This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.",
-          "type": "string"
         }
       }
     },
-    "policy": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
+        "description": "Streams the object back to the client honouring Range and If-None-Match request headers.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Download Object",
+        "operationId": "DownloadObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object to download",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object to download, defaults to the latest",
+            "name": "version_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "policyEntity": {
-      "type": "string",
-      "default": "user",
-      "enum": [
-        "user",
-        "group"
-      ]
+    "/buckets/{bucket_name}/objects/download-zip": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Download every object under a prefix as a zip archive",
+        "operationId": "DownloadObjectsZip",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "principal": {
-      "type": "object",
-      "properties": {
-        "accessKeyID": {
-          "type": "string"
-        },
-        "actions": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{bucket_name}/objects/folder": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Creates an empty prefix so it's listed as a folder",
+        "operationId": "CreateFolder",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createFolderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "secretAccessKey": {
-          "type": "string"
-        },
-        "sessionToken": {
-          "type": "string"
         }
       }
     },
-    "profilerType": {
-      "type": "string",
-      "enum": [
-        "cpu",
-        "mem",
-        "block",
-        "mutex",
-        "trace",
-        "threads",
-        "goroutines"
-      ]
-    },
-    "profilingStartRequest": {
-      "type": "object",
-      "required": [
-        "type"
-      ],
-      "properties": {
-        "type": {
-          "$ref": "#/definitions/profilerType"
+    "/buckets/{bucket_name}/objects/legal-hold": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets or clears the legal hold of an object",
+        "operationId": "PutObjectLegalHold",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectLegalHoldRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "resourceQuota": {
-      "type": "object",
-      "properties": {
-        "elements": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/resourceQuotaElement"
+    "/buckets/{bucket_name}/objects/metadata": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns the user-defined metadata and system headers of an object",
+        "operationId": "GetObjectMetadata",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectMetadata"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "type": "string"
         }
       }
     },
-    "resourceQuotaElement": {
-      "type": "object",
-      "properties": {
-        "hard": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "name": {
-          "type": "string"
-        },
-        "used": {
-          "type": "integer",
-          "format": "int64"
+    "/buckets/{bucket_name}/objects/preview": {
+      "get": {
+        "description": "Images are returned as they are with their detected content type, any other object is described by an objectPreview JSON document holding the beginning of text objects.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns a bounded preview of an object",
+        "operationId": "PreviewObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object to preview",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "serviceAccountCreds": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string"
+    "/buckets/{bucket_name}/objects/restore": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Restore an older version of an object as its latest version",
+        "operationId": "RestoreObjectVersion",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version to restore",
+            "name": "version_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectVersion"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "serviceAccountRequest": {
-      "type": "object",
-      "properties": {
-        "policy": {
-          "type": "string",
-          "title": "policy to be applied to the Service Account if any"
+    "/buckets/{bucket_name}/objects/retention": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets or extends the retention of an object",
+        "operationId": "PutObjectRetention",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectRetentionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "serviceAccounts": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      }
-    },
-    "sessionResponse": {
-      "type": "object",
-      "properties": {
-        "pages": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{bucket_name}/objects/select": {
+      "post": {
+        "description": "Streams back newline delimited JSON events holding the matching records, the progress of the query when requested and its final stats.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Runs a SQL expression over a CSV, JSON or Parquet object",
+        "operationId": "SelectObjectContent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object to query",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/selectObjectRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "ok"
-          ]
         }
       }
     },
-    "setBucketPolicyRequest": {
-      "type": "object",
-      "required": [
-        "access"
-      ],
-      "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
+    "/buckets/{bucket_name}/objects/share": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Generate a presigned link to share an object",
+        "operationId": "ShareObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/shareObjectRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/shareObjectResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "setConfigRequest": {
-      "type": "object",
-      "required": [
-        "key_values"
-      ],
-      "properties": {
-        "arn_resource_id": {
-          "type": "string",
-          "title": "Used if configuration is an event notification's target"
-        },
-        "key_values": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "$ref": "#/definitions/configurationKV"
+    "/buckets/{bucket_name}/objects/tags": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns the tags of an object",
+        "operationId": "GetObjectTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectTags"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Replaces the tags of an object",
+        "operationId": "PutObjectTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "version of the object, the latest one if not set",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/objectTags"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/objectTags"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "setPolicyRequest": {
-      "type": "object",
-      "required": [
-        "entityType",
-        "entityName"
-      ],
-      "properties": {
-        "entityName": {
-          "type": "string"
-        },
-        "entityType": {
-          "$ref": "#/definitions/policyEntity"
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "description": "Streams every file of a multipart/form-data body into the bucket. An optional metadata field holding a JSON object of user metadata applies to the files that follow it.",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Uploads Objects",
+        "operationId": "UploadObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/uploadObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "startProfilingItem": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "nodeName": {
-          "type": "string"
-        },
-        "success": {
-          "type": "boolean"
+    "/buckets/{bucket_name}/objects/versions": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List every version and delete marker of an object",
+        "operationId": "ListObjectVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "name of the object",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listObjectVersionsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "startProfilingList": {
-      "type": "object",
-      "properties": {
-        "startResults": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/startProfilingItem"
+    "/buckets/{bucket_name}/policy": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns the raw policy document of a bucket",
+        "operationId": "GetBucketPolicyDocument",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketPolicyDocument"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of start results"
         }
-      }
-    },
-    "tenant": {
-      "type": "object",
-      "properties": {
-        "creation_date": {
-          "type": "string"
-        },
-        "currentState": {
-          "type": "string"
-        },
-        "image": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "namespace": {
-          "type": "string"
-        },
-        "total_size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "zones": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/zone"
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Validates and replaces the policy document of a bucket, an empty document removes the policy",
+        "operationId": "SetBucketPolicyDocument",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketPolicyDocument"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketPolicyDocument"
+            }
+          },
+          "400": {
+            "description": "The policy document is not valid.",
+            "schema": {
+              "$ref": "#/definitions/bucketPolicyValidationErrors"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "tenantList": {
-      "type": "object",
-      "properties": {
-        "creation_date": {
-          "type": "string"
-        },
-        "currentState": {
-          "type": "string"
-        },
-        "instance_count": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "namespace": {
-          "type": "string"
-        },
-        "total_size": {
-          "type": "integer"
-        },
-        "volume_count": {
-          "type": "integer"
-        },
-        "zone_count": {
-          "type": "integer"
+    "/buckets/{bucket_name}/policy/anonymous": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Lists the anonymous access granted on each prefix of a bucket",
+        "operationId": "ListBucketAnonymousAccess",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketAnonymousAccessResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "tenantUsage": {
-      "type": "object",
-      "properties": {
-        "disk_used": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "used": {
-          "type": "integer",
-          "format": "int64"
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets the anonymous access of a prefix keeping the rest of the bucket policy",
+        "operationId": "SetBucketAnonymousAccess",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/anonymousAccessRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketAnonymousAccessResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tlsConfiguration": {
-      "type": "object",
-      "properties": {
-        "console": {
-          "type": "object",
-          "$ref": "#/definitions/keyPairConfiguration"
-        },
-        "minio": {
-          "type": "object",
-          "$ref": "#/definitions/keyPairConfiguration"
+    "/buckets/{bucket_name}/replication": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get the replication rules of a bucket",
+        "operationId": "GetBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Add a replication rule to a bucket",
+        "operationId": "AddBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "updateGroupRequest": {
-      "type": "object",
-      "required": [
-        "members",
-        "status"
-      ],
-      "properties": {
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{bucket_name}/replication/{rule_id}": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Update a replication rule of a bucket",
+        "operationId": "UpdateBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete a replication rule of a bucket",
+        "operationId": "DeleteBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "updateTenantRequest": {
-      "type": "object",
-      "properties": {
-        "console_image": {
-          "type": "string",
-          "pattern": "^((.*?)/(.*?):(.+))$"
-        },
-        "image": {
-          "type": "string",
-          "pattern": "^((.*?)/(.*?):(.+))$"
-        },
-        "image_pull_secret": {
-          "type": "string"
-        },
-        "image_registry": {
-          "$ref": "#/definitions/imageRegistry"
+    "/buckets/{bucket_name}/retention": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets the default retention of a bucket with object locking enabled",
+        "operationId": "SetBucketRetention",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketRetention"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "updateUser": {
-      "type": "object",
-      "required": [
-        "status",
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{bucket_name}/tags": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns the tags of a bucket",
+        "operationId": "GetBucketTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketTags"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Replaces the tags of a bucket",
+        "operationId": "PutBucketTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketTags"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketTags"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Removes every tag of a bucket",
+        "operationId": "DeleteBucketTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "updateUserGroups": {
-      "type": "object",
-      "required": [
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{bucket_name}/versioning": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Enable or suspend versioning on a bucket",
+        "operationId": "SetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketVersioningRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "user": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "memberOf": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{name}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
-        },
-        "policy": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
-        }
-      }
-    },
-    "vaultConfiguration": {
-      "type": "object",
-      "required": [
-        "endpoint",
-        "approle"
-      ],
-      "properties": {
-        "approle": {
-          "type": "object",
-          "required": [
-            "id",
-            "secret"
-          ],
-          "properties": {
-            "engine": {
-              "type": "string"
-            },
-            "id": {
-              "type": "string"
-            },
-            "retry": {
-              "type": "integer",
-              "format": "int64"
-            },
-            "secret": {
-              "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
             }
-          }
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "engine": {
-          "type": "string"
-        },
-        "namespace": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "status": {
-          "type": "object",
-          "properties": {
-            "ping": {
-              "type": "integer",
-              "format": "int64"
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
-        },
-        "tls": {
-          "type": "object",
-          "properties": {
-            "ca": {
-              "type": "string"
-            },
-            "crt": {
-              "type": "string"
-            },
-            "key": {
-              "type": "string"
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Bucket",
+        "operationId": "DeleteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "remove every object of the bucket before deleting it",
+            "name": "force",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "zone": {
-      "type": "object",
-      "required": [
-        "servers",
-        "volumes_per_server",
-        "volume_configuration"
-      ],
-      "properties": {
-        "affinity": {
-          "$ref": "#/definitions/zoneAffinity"
-        },
-        "name": {
-          "type": "string"
-        },
-        "node_selector": {
-          "description": "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/buckets/{name}/quota": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets, changes or removes the quota of a bucket",
+        "operationId": "SetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
           }
-        },
-        "resources": {
-          "$ref": "#/definitions/zoneResources"
-        },
-        "servers": {
-          "type": "integer"
-        },
-        "tolerations": {
-          "$ref": "#/definitions/zoneTolerations"
-        },
-        "volume_configuration": {
-          "type": "object",
-          "required": [
-            "size"
-          ],
-          "properties": {
-            "size": {
-              "type": "integer"
-            },
-            "storage_class_name": {
-              "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
-        },
-        "volumes_per_server": {
-          "type": "integer",
-          "format": "int32"
         }
       }
     },
-    "zoneAffinity": {
-      "description": "If specified, affinity will define the pod's scheduling constraints",
-      "type": "object",
-      "properties": {
-        "nodeAffinity": {
-          "description": "Describes node affinity scheduling rules for the pod.",
-          "type": "object",
-          "properties": {
-            "preferredDuringSchedulingIgnoredDuringExecution": {
-              "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.",
-              "type": "array",
-              "items": {
-                "description": "An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).",
-                "type": "object",
-                "required": [
-                  "preference",
-                  "weight"
-                ],
-                "properties": {
-                  "preference": {
-                    "description": "A node selector term, associated with the corresponding weight.",
-                    "type": "object",
-                    "$ref": "#/definitions/nodeSelectorTerm"
-                  },
-                  "weight": {
-                    "description": "Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.",
-                    "type": "integer",
-                    "format": "int32"
-                  }
-                }
-              }
-            },
-            "requiredDuringSchedulingIgnoredDuringExecution": {
-              "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.",
-              "type": "object",
-              "required": [
-                "nodeSelectorTerms"
-              ],
-              "properties": {
-                "nodeSelectorTerms": {
-                  "description": "Required. A list of node selector terms. The terms are ORed.",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/nodeSelectorTerm"
-                  }
-                }
-              }
+    "/buckets/{name}/set-policy": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Set Policy",
+        "operationId": "BucketSetPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketPolicyRequest"
             }
           }
-        },
-        "podAffinity": {
-          "description": "Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).",
-          "type": "object",
-          "properties": {
-            "preferredDuringSchedulingIgnoredDuringExecution": {
-              "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
-              "type": "array",
-              "items": {
-                "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
-                "type": "object",
-                "required": [
-                  "podAffinityTerm",
-                  "weight"
-                ],
-                "properties": {
-                  "podAffinityTerm": {
-                    "$ref": "#/definitions/podAffinityTerm"
-                  },
-                  "weight": {
-                    "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
-                    "type": "integer",
-                    "format": "int32"
-                  }
-                }
-              }
-            },
-            "requiredDuringSchedulingIgnoredDuringExecution": {
-              "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/podAffinityTerm"
-              }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
             }
-          }
-        },
-        "podAntiAffinity": {
-          "description": "Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).",
-          "type": "object",
-          "properties": {
-            "preferredDuringSchedulingIgnoredDuringExecution": {
-              "description": "The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
-              "type": "array",
-              "items": {
-                "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
-                "type": "object",
-                "required": [
-                  "podAffinityTerm",
-                  "weight"
-                ],
-                "properties": {
-                  "podAffinityTerm": {
-                    "$ref": "#/definitions/podAffinityTerm"
-                  },
-                  "weight": {
-                    "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
-                    "type": "integer",
-                    "format": "int32"
-                  }
-                }
-              }
-            },
-            "requiredDuringSchedulingIgnoredDuringExecution": {
-              "description": "If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/podAffinityTerm"
-              }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
     },
-    "zoneResources": {
-      "description": "If provided, use these requests and limit for cpu/memory resource allocation",
-      "type": "object",
-      "properties": {
-        "limits": {
-          "description": "Limits describes the maximum amount of compute resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/",
-          "type": "object",
-          "additionalProperties": {
+    "/configs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Configurations",
+        "operationId": "ListConfig",
+        "parameters": [
+          {
             "type": "integer",
-            "format": "int64"
-          }
-        },
-        "requests": {
-          "description": "Requests describes the minimum amount of compute resources required. If Requests is omitted for a container, it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/",
-          "type": "object",
-          "additionalProperties": {
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
             "type": "integer",
-            "format": "int64"
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "zoneTolerationSeconds": {
-      "description": "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
-      "type": "object",
-      "required": [
-        "seconds"
-      ],
-      "properties": {
-        "seconds": {
-          "type": "integer",
-          "format": "int64"
+    "/configs/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Configuration info",
+        "operationId": "ConfigInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configuration"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set Configuration",
+        "operationId": "SetConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setConfigRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "zoneTolerations": {
-      "description": "Tolerations allows users to set entries like effect, key, operator, value.",
-      "type": "array",
-      "items": {
-        "description": "The pod this Toleration is attached to tolerates any taint that matches the triple \u003ckey,value,effect\u003e using the matching operator \u003coperator\u003e.",
-        "type": "object",
-        "properties": {
-          "effect": {
-            "description": "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
-            "type": "string"
-          },
-          "key": {
-            "description": "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
-            "type": "string"
+    "/groups": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Groups",
+        "operationId": "ListGroups",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
           },
-          "operator": {
-            "description": "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
-            "type": "string"
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listGroupsResponse"
+            }
           },
-          "tolerationSeconds": {
-            "$ref": "#/definitions/zoneTolerationSeconds"
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add Group",
+        "operationId": "AddGroup",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
           },
-          "value": {
-            "description": "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
-            "type": "string"
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
-    }
-  },
-  "securityDefinitions": {
-    "key": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://min.io",
-      "tokenUrl": "http://min.io"
-    }
-  },
-  "security": [
-    {
-      "key": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "ws"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "MinIO Console Server",
-    "version": "0.1.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/admin/arns": {
+    },
+    "/groups/{name}": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns a list of active ARNs in the instance",
-        "operationId": "ArnList",
+        "summary": "Group info",
+        "operationId": "GroupInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/arnsResponse"
+              "$ref": "#/definitions/group"
             }
           },
           "default": {
@@ -3489,21 +9591,61 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/admin/info": {
-      "get": {
+      },
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "AdminInfo",
+        "summary": "Update Group Members or Status",
+        "operationId": "UpdateGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateGroupRequest"
+            }
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/adminInfoResponse"
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove group",
+        "operationId": "RemoveGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3514,18 +9656,18 @@ func init() {
         }
       }
     },
-    "/admin/notification_endpoints": {
+    "/iam/snapshot": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns a list of active notification endpoints",
-        "operationId": "NotificationEndpointList",
+        "summary": "Exports policies, users, groups and their policy mappings as a snapshot",
+        "operationId": "ExportIamSnapshot",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/notifEndpointResponse"
+              "$ref": "#/definitions/iamSnapshot"
             }
           },
           "default": {
@@ -3535,28 +9677,30 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/iam/snapshot/import": {
       "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Allows to configure a new notification endpoint",
-        "operationId": "AddNotificationEndpoint",
+        "summary": "Applies an IAM snapshot, or only returns the changes it would apply on dry run",
+        "operationId": "ImportIamSnapshot",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/notificationEndpoint"
+              "$ref": "#/definitions/iamSnapshotImportRequest"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/notificationEndpoint"
+              "$ref": "#/definitions/iamSnapshotImportResponse"
             }
           },
           "default": {
@@ -3568,37 +9712,19 @@ func init() {
         }
       }
     },
-    "/buckets": {
+    "/login": {
       "get": {
+        "security": [],
         "tags": [
           "UserAPI"
         ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "sort_by",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/loginDetails"
             }
           },
           "default": {
@@ -3610,24 +9736,28 @@ func init() {
         }
       },
       "post": {
+        "security": [],
         "tags": [
           "UserAPI"
         ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
+        "summary": "Login to Console",
+        "operationId": "Login",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
+              "$ref": "#/definitions/loginRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3638,38 +9768,63 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events": {
-      "get": {
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
         "tags": [
           "UserAPI"
         ],
-        "summary": "List Bucket Events",
-        "operationId": "ListBucketEvents",
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOauth2AuthRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/login/operator": {
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Login to Operator Console.",
+        "operationId": "LoginOperator",
+        "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOperatorRequest"
+            }
           }
         ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
+        "responses": {
+          "201": {
+            "description": "A successful login.",
             "schema": {
-              "$ref": "#/definitions/listBucketEventsResponse"
+              "$ref": "#/definitions/loginResponse"
             }
           },
           "default": {
@@ -3679,31 +9834,17 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/logout": {
       "post": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Create Bucket Event",
-        "operationId": "CreateBucketEvent",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketEventRequest"
-            }
-          }
-        ],
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -3715,38 +9856,33 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events/{arn}": {
-      "delete": {
+    "/namespaces/{namespace}/resourcequotas/{resource-quota-name}": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Delete Bucket Event",
-        "operationId": "DeleteBucketEvent",
+        "summary": "Get Resource Quota",
+        "operationId": "GetResourceQuota",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "namespace",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "arn",
+            "name": "resource-quota-name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/notificationDeleteRequest"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/resourceQuota"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3757,26 +9893,43 @@ func init() {
         }
       }
     },
-    "/buckets/{name}": {
+    "/namespaces/{namespace}/tenants": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
+        "summary": "List Tenants by Namespace",
+        "operationId": "ListTenants",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "namespace",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/listTenantsResponse"
             }
           },
           "default": {
@@ -3786,24 +9939,35 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Delete Bucket",
-        "operationId": "DeleteBucket",
+        "summary": "Tenant Info",
+        "operationId": "TenantInfo",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenant"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3812,19 +9976,23 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{name}/set-policy": {
+      },
       "put": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Bucket Set Policy",
-        "operationId": "BucketSetPolicy",
+        "summary": "Update Tenant",
+        "operationId": "UpdateTenant",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
             "in": "path",
             "required": true
           },
@@ -3833,16 +10001,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketPolicyRequest"
+              "$ref": "#/definitions/updateTenantRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3851,35 +10016,30 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/configs": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Configurations",
-        "operationId": "ListConfig",
+        "summary": "Delete Tenant",
+        "operationId": "DeleteTenant",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listConfigResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3890,17 +10050,23 @@ func init() {
         }
       }
     },
-    "/configs/{name}": {
+    "/namespaces/{namespace}/tenants/{tenant}/usage": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Configuration info",
-        "operationId": "ConfigInfo",
+        "summary": "Get Usage For The Tenant",
+        "operationId": "GetTenantUsage",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
             "in": "path",
             "required": true
           }
@@ -3909,7 +10075,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/configuration"
+              "$ref": "#/definitions/tenantUsage"
             }
           },
           "default": {
@@ -3919,17 +10085,25 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/zones": {
+      "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Set Configuration",
-        "operationId": "SetConfig",
+        "summary": "Tenant Add Zone",
+        "operationId": "TenantAddZone",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
             "in": "path",
             "required": true
           },
@@ -3938,12 +10112,12 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setConfigRequest"
+              "$ref": "#/definitions/zone"
             }
           }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -3955,13 +10129,13 @@ func init() {
         }
       }
     },
-    "/groups": {
+    "/policies": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Groups",
-        "operationId": "ListGroups",
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
         "parameters": [
           {
             "type": "integer",
@@ -3980,7 +10154,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listGroupsResponse"
+              "$ref": "#/definitions/listPoliciesResponse"
             }
           },
           "default": {
@@ -3995,51 +10169,23 @@ func init() {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Add Group",
-        "operationId": "AddGroup",
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addGroupRequest"
+              "$ref": "#/definitions/addPolicyRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/groups/{name}": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Group info",
-        "operationId": "GroupInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/policy"
             }
           },
           "default": {
@@ -4049,34 +10195,28 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/policies/{name}": {
+      "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Update Group Members or Status",
-        "operationId": "UpdateGroup",
+        "summary": "Policy info",
+        "operationId": "PolicyInfo",
         "parameters": [
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateGroupRequest"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/policy"
             }
           },
           "default": {
@@ -4091,8 +10231,8 @@ func init() {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Remove group",
-        "operationId": "RemoveGroup",
+        "summary": "Remove policy",
+        "operationId": "RemovePolicy",
         "parameters": [
           {
             "type": "string",
@@ -4114,19 +10254,28 @@ func init() {
         }
       }
     },
-    "/login": {
-      "get": {
-        "security": [],
+    "/profiling/start": {
+      "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
+        ],
+        "summary": "Start recording profile data",
+        "operationId": "ProfilingStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/profilingStartRequest"
+            }
+          }
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "$ref": "#/definitions/startProfilingList"
             }
           },
           "default": {
@@ -4136,29 +10285,23 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/profiling/stop": {
       "post": {
-        "security": [],
-        "tags": [
-          "UserAPI"
+        "produces": [
+          "application/octet-stream"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginRequest"
-            }
-          }
+        "tags": [
+          "AdminAPI"
         ],
+        "summary": "Stop and download profile data",
+        "operationId": "ProfilingStop",
         "responses": {
           "201": {
-            "description": "A successful login.",
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -4170,29 +10313,32 @@ func init() {
         }
       }
     },
-    "/login/oauth2/auth": {
-      "post": {
-        "security": [],
+    "/service-accounts": {
+      "get": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Identity Provider oauth2 callback endpoint.",
-        "operationId": "LoginOauth2Auth",
+        "summary": "List User's Service Accounts",
+        "operationId": "ListUserServiceAccounts",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginOauth2AuthRequest"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful login.",
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginResponse"
+              "$ref": "#/definitions/serviceAccounts"
             }
           },
           "default": {
@@ -4202,31 +10348,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/login/operator": {
+      },
       "post": {
-        "security": [],
         "tags": [
           "UserAPI"
         ],
-        "summary": "Login to Operator Console.",
-        "operationId": "LoginOperator",
+        "summary": "Create Service Account",
+        "operationId": "CreateServiceAccount",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginOperatorRequest"
+              "$ref": "#/definitions/serviceAccountRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful login.",
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginResponse"
+              "$ref": "#/definitions/serviceAccountCreds"
             }
           },
           "default": {
@@ -4238,53 +10381,24 @@ func init() {
         }
       }
     },
-    "/logout": {
-      "post": {
+    "/service-accounts/{access_key}": {
+      "delete": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/namespaces/{namespace}/resourcequotas/{resource-quota-name}": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Get Resource Quota",
-        "operationId": "GetResourceQuota",
+        "summary": "Delete Service Account",
+        "operationId": "DeleteServiceAccount",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "resource-quota-name",
+            "name": "access_key",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/resourceQuota"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -4295,44 +10409,16 @@ func init() {
         }
       }
     },
-    "/namespaces/{namespace}/tenants": {
-      "get": {
+    "/service/restart": {
+      "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Tenants by Namespace",
-        "operationId": "ListTenants",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "namespace",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "sort_by",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
+        "summary": "Restart Service",
+        "operationId": "RestartService",
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listTenantsResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -4343,32 +10429,18 @@ func init() {
         }
       }
     },
-    "/namespaces/{namespace}/tenants/{tenant}": {
+    "/session": {
       "get": {
         "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Tenant Info",
-        "operationId": "TenantInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "namespace",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
-          }
+          "UserAPI"
         ],
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tenant"
+              "$ref": "#/definitions/sessionResponse"
             }
           },
           "default": {
@@ -4378,38 +10450,57 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/set-policy/{name}": {
       "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Update Tenant",
-        "operationId": "UpdateTenant",
+        "summary": "Attaches or detaches policies of a user or group, the path accepts a comma separated list",
+        "operationId": "SetPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+          "default": {
+            "description": "Generic error response.",
             "schema": {
-              "$ref": "#/definitions/updateTenantRequest"
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/shares": {
+      "get": {
+        "tags": [
+          "AdminAPI"
         ],
+        "summary": "List active share links",
+        "operationId": "ListShares",
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listSharesResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -4418,30 +10509,31 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/simulate-policy": {
+      "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Delete Tenant",
-        "operationId": "DeleteTenant",
+        "summary": "Evaluates whether a user, group or service account can perform an action on a resource",
+        "operationId": "SimulatePolicy",
         "parameters": [
           {
-            "type": "string",
-            "name": "namespace",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policySimulationRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policySimulationResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -4452,32 +10544,37 @@ func init() {
         }
       }
     },
-    "/namespaces/{namespace}/tenants/{tenant}/usage": {
+    "/tenants": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Get Usage For The Tenant",
-        "operationId": "GetTenantUsage",
+        "summary": "List Tenant of All Namespaces",
+        "operationId": "ListAllTenants",
         "parameters": [
           {
             "type": "string",
-            "name": "namespace",
-            "in": "path",
-            "required": true
+            "name": "sort_by",
+            "in": "query"
           },
           {
-            "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tenantUsage"
+              "$ref": "#/definitions/listTenantsResponse"
             }
           },
           "default": {
@@ -4487,40 +10584,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/namespaces/{namespace}/tenants/{tenant}/zones": {
+      },
       "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Tenant Add Zone",
-        "operationId": "TenantAddZone",
+        "summary": "Create Tenant",
+        "operationId": "CreateTenant",
         "parameters": [
-          {
-            "type": "string",
-            "name": "namespace",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "tenant",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/zone"
+              "$ref": "#/definitions/createTenantRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/createTenantResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -4531,13 +10617,13 @@ func init() {
         }
       }
     },
-    "/policies": {
+    "/users": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
+        "summary": "List Users",
+        "operationId": "ListUsers",
         "parameters": [
           {
             "type": "integer",
@@ -4556,7 +10642,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/listUsersResponse"
             }
           },
           "default": {
@@ -4571,15 +10657,15 @@ func init() {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
+        "summary": "Add User",
+        "operationId": "AddUser",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addPolicyRequest"
+              "$ref": "#/definitions/addUserRequest"
             }
           }
         ],
@@ -4587,7 +10673,7 @@ func init() {
           "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -4599,26 +10685,21 @@ func init() {
         }
       }
     },
-    "/policies/{name}": {
+    "/users-export": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Policy info",
-        "operationId": "PolicyInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "Downloads the users with their groups, policies and status as CSV",
+        "operationId": "ExportUsers",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
+              "type": "file"
             }
           },
           "default": {
@@ -4628,23 +10709,27 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/users-groups-bulk": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Remove policy",
-        "operationId": "RemovePolicy",
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkUserGroups"
+            }
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -4656,28 +10741,28 @@ func init() {
         }
       }
     },
-    "/profiling/start": {
+    "/users-import": {
       "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Start recording profile data",
-        "operationId": "ProfilingStart",
+        "summary": "Creates the users of a CSV file",
+        "operationId": "ImportUsers",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/profilingStartRequest"
+              "$ref": "#/definitions/importUsersRequest"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/startProfilingList"
+              "$ref": "#/definitions/importUsersResponse"
             }
           },
           "default": {
@@ -4689,18 +10774,26 @@ func init() {
         }
       }
     },
-    "/profiling/stop": {
-      "post": {
+    "/users-import/secrets/{token}": {
+      "get": {
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Stop and download profile data",
-        "operationId": "ProfilingStop",
+        "summary": "Downloads once the secret keys generated by a users import as CSV",
+        "operationId": "DownloadImportedUsersSecrets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "token",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
               "type": "file"
@@ -4715,32 +10808,26 @@ func init() {
         }
       }
     },
-    "/service-accounts": {
+    "/users/{name}": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "List User's Service Accounts",
-        "operationId": "ListUserServiceAccounts",
+        "summary": "Get User Info",
+        "operationId": "GetUserInfo",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -4751,27 +10838,33 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Create Service Account",
-        "operationId": "CreateServiceAccount",
+        "summary": "Update User Info",
+        "operationId": "UpdateUserInfo",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
+              "$ref": "#/definitions/updateUser"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -4781,43 +10874,21 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/service-accounts/{access_key}": {
+      },
       "delete": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Delete Service Account",
-        "operationId": "DeleteServiceAccount",
+        "summary": "Remove user",
+        "operationId": "RemoveUser",
         "parameters": [
           {
             "type": "string",
-            "name": "access_key",
+            "name": "name",
             "in": "path",
             "required": true
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/service/restart": {
-      "post": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Restart Service",
-        "operationId": "RestartService",
+        ],
         "responses": {
           "204": {
             "description": "A successful response."
@@ -4831,18 +10902,26 @@ func init() {
         }
       }
     },
-    "/session": {
+    "/users/{name}/effective-policy": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
+        ],
+        "summary": "Returns the policies of the user merged with the policies of its groups",
+        "operationId": "GetUserEffectivePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/sessionResponse"
+              "$ref": "#/definitions/userEffectivePolicy"
             }
           },
           "default": {
@@ -4854,13 +10933,13 @@ func init() {
         }
       }
     },
-    "/set-policy/{name}": {
+    "/users/{name}/groups": {
       "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Set policy",
-        "operationId": "SetPolicy",
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
         "parameters": [
           {
             "type": "string",
@@ -4873,13 +10952,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setPolicyRequest"
+              "$ref": "#/definitions/updateUserGroups"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -4889,1048 +10971,1277 @@ func init() {
           }
         }
       }
+    }
+  },
+  "definitions": {
+    "AwsConfigurationSecretsmanager": {
+      "type": "object",
+      "required": [
+        "endpoint",
+        "region",
+        "credentials"
+      ],
+      "properties": {
+        "credentials": {
+          "type": "object",
+          "required": [
+            "accesskey",
+            "secretkey"
+          ],
+          "properties": {
+            "accesskey": {
+              "type": "string"
+            },
+            "secretkey": {
+              "type": "string"
+            },
+            "token": {
+              "type": "string"
+            }
+          }
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "kmskey": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        }
+      }
+    },
+    "AwsConfigurationSecretsmanagerCredentials": {
+      "type": "object",
+      "required": [
+        "accesskey",
+        "secretkey"
+      ],
+      "properties": {
+        "accesskey": {
+          "type": "string"
+        },
+        "secretkey": {
+          "type": "string"
+        },
+        "token": {
+          "type": "string"
+        }
+      }
+    },
+    "CreateTenantResponseConsole": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "GemaltoConfigurationKeysecure": {
+      "type": "object",
+      "required": [
+        "endpoint",
+        "credentials"
+      ],
+      "properties": {
+        "credentials": {
+          "type": "object",
+          "required": [
+            "token",
+            "domain"
+          ],
+          "properties": {
+            "domain": {
+              "type": "string"
+            },
+            "retry": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "token": {
+              "type": "string"
+            }
+          }
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "tls": {
+          "type": "object",
+          "required": [
+            "ca"
+          ],
+          "properties": {
+            "ca": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "GemaltoConfigurationKeysecureCredentials": {
+      "type": "object",
+      "required": [
+        "token",
+        "domain"
+      ],
+      "properties": {
+        "domain": {
+          "type": "string"
+        },
+        "retry": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "token": {
+          "type": "string"
+        }
+      }
+    },
+    "GemaltoConfigurationKeysecureTLS": {
+      "type": "object",
+      "required": [
+        "ca"
+      ],
+      "properties": {
+        "ca": {
+          "type": "string"
+        }
+      }
+    },
+    "IdpConfigurationActiveDirectory": {
+      "type": "object",
+      "required": [
+        "url",
+        "username_format",
+        "user_search_filter"
+      ],
+      "properties": {
+        "group_name_attribute": {
+          "type": "string"
+        },
+        "group_search_base_dn": {
+          "type": "string"
+        },
+        "group_search_filter": {
+          "type": "string"
+        },
+        "server_insecure": {
+          "type": "boolean"
+        },
+        "skip_tls_verification": {
+          "type": "boolean"
+        },
+        "url": {
+          "type": "string"
+        },
+        "user_search_filter": {
+          "type": "string"
+        },
+        "username_format": {
+          "type": "string"
+        }
+      }
+    },
+    "IdpConfigurationOidc": {
+      "type": "object",
+      "required": [
+        "url",
+        "client_id",
+        "secret_id"
+      ],
+      "properties": {
+        "client_id": {
+          "type": "string"
+        },
+        "secret_id": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "NodeSelectorTermMatchExpressionsItems0": {
+      "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+      "type": "object",
+      "required": [
+        "key",
+        "operator"
+      ],
+      "properties": {
+        "key": {
+          "description": "The label key that the selector applies to.",
+          "type": "string"
+        },
+        "operator": {
+          "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+          "type": "string"
+        },
+        "values": {
+          "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "NodeSelectorTermMatchFieldsItems0": {
+      "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+      "type": "object",
+      "required": [
+        "key",
+        "operator"
+      ],
+      "properties": {
+        "key": {
+          "description": "The label key that the selector applies to.",
+          "type": "string"
+        },
+        "operator": {
+          "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+          "type": "string"
+        },
+        "values": {
+          "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
     },
-    "/tenants": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "List Tenant of All Namespaces",
-        "operationId": "ListAllTenants",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "sort_by",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+    "PodAffinityTermLabelSelector": {
+      "description": "A label query over a set of resources, in this case pods.",
+      "type": "object",
+      "properties": {
+        "matchExpressions": {
+          "description": "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PodAffinityTermLabelSelectorMatchExpressionsItems0"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listTenantsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "matchLabels": {
+          "description": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
         }
-      },
-      "post": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Create Tenant",
-        "operationId": "CreateTenant",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createTenantRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/createTenantResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "PodAffinityTermLabelSelectorMatchExpressionsItems0": {
+      "description": "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+      "type": "object",
+      "required": [
+        "key",
+        "operator"
+      ],
+      "properties": {
+        "key": {
+          "description": "key is the label key that the selector applies to.",
+          "type": "string"
+        },
+        "operator": {
+          "description": "operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.",
+          "type": "string"
+        },
+        "values": {
+          "description": "values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "/users": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "List Users",
-        "operationId": "ListUsers",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listUsersResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "VaultConfigurationApprole": {
+      "type": "object",
+      "required": [
+        "id",
+        "secret"
+      ],
+      "properties": {
+        "engine": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "retry": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "secret": {
+          "type": "string"
         }
-      },
-      "post": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Add User",
-        "operationId": "AddUser",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addUserRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "VaultConfigurationStatus": {
+      "type": "object",
+      "properties": {
+        "ping": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/users-groups-bulk": {
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
+    "VaultConfigurationTLS": {
+      "type": "object",
+      "properties": {
+        "ca": {
+          "type": "string"
+        },
+        "crt": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "ZoneAffinityNodeAffinity": {
+      "description": "Describes node affinity scheduling rules for the pod.",
+      "type": "object",
+      "properties": {
+        "preferredDuringSchedulingIgnoredDuringExecution": {
+          "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ZoneAffinityNodeAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0"
+          }
+        },
+        "requiredDuringSchedulingIgnoredDuringExecution": {
+          "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.",
+          "type": "object",
+          "required": [
+            "nodeSelectorTerms"
+          ],
+          "properties": {
+            "nodeSelectorTerms": {
+              "description": "Required. A list of node selector terms. The terms are ORed.",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/nodeSelectorTerm"
+              }
             }
           }
         }
       }
     },
-    "/users/{name}": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Get User Info",
-        "operationId": "GetUserInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "ZoneAffinityNodeAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0": {
+      "description": "An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).",
+      "type": "object",
+      "required": [
+        "preference",
+        "weight"
+      ],
+      "properties": {
+        "preference": {
+          "description": "A node selector term, associated with the corresponding weight.",
+          "type": "object",
+          "$ref": "#/definitions/nodeSelectorTerm"
+        },
+        "weight": {
+          "description": "Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.",
+          "type": "integer",
+          "format": "int32"
         }
-      },
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Update User Info",
-        "operationId": "UpdateUserInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUser"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "ZoneAffinityNodeAffinityRequiredDuringSchedulingIgnoredDuringExecution": {
+      "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.",
+      "type": "object",
+      "required": [
+        "nodeSelectorTerms"
+      ],
+      "properties": {
+        "nodeSelectorTerms": {
+          "description": "Required. A list of node selector terms. The terms are ORed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/nodeSelectorTerm"
           }
         }
-      },
-      "delete": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Remove user",
-        "operationId": "RemoveUser",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+      }
+    },
+    "ZoneAffinityPodAffinity": {
+      "description": "Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).",
+      "type": "object",
+      "properties": {
+        "preferredDuringSchedulingIgnoredDuringExecution": {
+          "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ZoneAffinityPodAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "requiredDuringSchedulingIgnoredDuringExecution": {
+          "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/podAffinityTerm"
           }
         }
       }
     },
-    "/users/{name}/groups": {
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUserGroups"
-            }
+    "ZoneAffinityPodAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0": {
+      "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
+      "type": "object",
+      "required": [
+        "podAffinityTerm",
+        "weight"
+      ],
+      "properties": {
+        "podAffinityTerm": {
+          "$ref": "#/definitions/podAffinityTerm"
+        },
+        "weight": {
+          "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "ZoneAffinityPodAntiAffinity": {
+      "description": "Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).",
+      "type": "object",
+      "properties": {
+        "preferredDuringSchedulingIgnoredDuringExecution": {
+          "description": "The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ZoneAffinityPodAntiAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "requiredDuringSchedulingIgnoredDuringExecution": {
+          "description": "If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/podAffinityTerm"
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "AwsConfigurationSecretsmanager": {
+    },
+    "ZoneAffinityPodAntiAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0": {
+      "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
       "type": "object",
       "required": [
-        "endpoint",
-        "region",
-        "credentials"
+        "podAffinityTerm",
+        "weight"
       ],
       "properties": {
-        "credentials": {
-          "type": "object",
-          "required": [
-            "accesskey",
-            "secretkey"
-          ],
-          "properties": {
-            "accesskey": {
-              "type": "string"
-            },
-            "secretkey": {
-              "type": "string"
-            },
-            "token": {
-              "type": "string"
-            }
-          }
+        "podAffinityTerm": {
+          "$ref": "#/definitions/podAffinityTerm"
+        },
+        "weight": {
+          "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "ZoneTolerationsItems0": {
+      "description": "The pod this Toleration is attached to tolerates any taint that matches the triple \u003ckey,value,effect\u003e using the matching operator \u003coperator\u003e.",
+      "type": "object",
+      "properties": {
+        "effect": {
+          "description": "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+          "type": "string"
         },
-        "endpoint": {
+        "key": {
+          "description": "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
           "type": "string"
         },
-        "kmskey": {
+        "operator": {
+          "description": "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
           "type": "string"
         },
-        "region": {
+        "tolerationSeconds": {
+          "$ref": "#/definitions/zoneTolerationSeconds"
+        },
+        "value": {
+          "description": "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
           "type": "string"
         }
       }
     },
-    "AwsConfigurationSecretsmanagerCredentials": {
+    "ZoneVolumeConfiguration": {
       "type": "object",
       "required": [
-        "accesskey",
-        "secretkey"
+        "size"
       ],
       "properties": {
-        "accesskey": {
-          "type": "string"
+        "size": {
+          "type": "integer"
         },
-        "secretkey": {
+        "storage_class_name": {
           "type": "string"
-        },
-        "token": {
+        }
+      }
+    },
+    "addGroupRequest": {
+      "type": "object",
+      "required": [
+        "group",
+        "members"
+      ],
+      "properties": {
+        "group": {
           "type": "string"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "CreateTenantResponseConsole": {
+    "addPolicyRequest": {
       "type": "object",
+      "required": [
+        "name",
+        "policy"
+      ],
       "properties": {
-        "access_key": {
+        "name": {
           "type": "string"
         },
-        "secret_key": {
+        "policy": {
           "type": "string"
         }
       }
     },
-    "GemaltoConfigurationKeysecure": {
+    "addUserRequest": {
       "type": "object",
       "required": [
-        "endpoint",
-        "credentials"
+        "accessKey",
+        "secretKey",
+        "groups"
       ],
       "properties": {
-        "credentials": {
-          "type": "object",
-          "required": [
-            "token",
-            "domain"
-          ],
-          "properties": {
-            "domain": {
-              "type": "string"
-            },
-            "retry": {
-              "type": "integer",
-              "format": "int64"
-            },
-            "token": {
-              "type": "string"
-            }
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "endpoint": {
+        "secretKey": {
           "type": "string"
+        }
+      }
+    },
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "integer"
         },
-        "tls": {
-          "type": "object",
-          "required": [
-            "ca"
-          ],
-          "properties": {
-            "ca": {
-              "type": "string"
-            }
-          }
+        "objects": {
+          "type": "integer"
+        },
+        "usage": {
+          "type": "integer"
         }
       }
     },
-    "GemaltoConfigurationKeysecureCredentials": {
+    "anonymousAccessRule": {
       "type": "object",
       "required": [
-        "token",
-        "domain"
+        "access"
       ],
       "properties": {
-        "domain": {
-          "type": "string"
-        },
-        "retry": {
-          "type": "integer",
-          "format": "int64"
+        "access": {
+          "type": "string",
+          "title": "none removes the anonymous access of the prefix",
+          "enum": [
+            "none",
+            "readonly",
+            "writeonly",
+            "readwrite"
+          ]
         },
-        "token": {
-          "type": "string"
+        "prefix": {
+          "type": "string",
+          "title": "prefix the rule applies to, the whole bucket if empty"
         }
       }
     },
-    "GemaltoConfigurationKeysecureTLS": {
+    "arnsResponse": {
+      "type": "object",
+      "properties": {
+        "arns": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "awsConfiguration": {
       "type": "object",
       "required": [
-        "ca"
+        "secretsmanager"
       ],
       "properties": {
-        "ca": {
-          "type": "string"
+        "secretsmanager": {
+          "type": "object",
+          "required": [
+            "endpoint",
+            "region",
+            "credentials"
+          ],
+          "properties": {
+            "credentials": {
+              "type": "object",
+              "required": [
+                "accesskey",
+                "secretkey"
+              ],
+              "properties": {
+                "accesskey": {
+                  "type": "string"
+                },
+                "secretkey": {
+                  "type": "string"
+                },
+                "token": {
+                  "type": "string"
+                }
+              }
+            },
+            "endpoint": {
+              "type": "string"
+            },
+            "kmskey": {
+              "type": "string"
+            },
+            "region": {
+              "type": "string"
+            }
+          }
         }
       }
     },
-    "IdpConfigurationActiveDirectory": {
+    "bucket": {
       "type": "object",
       "required": [
-        "url",
-        "username_format",
-        "user_search_filter"
+        "name"
       ],
       "properties": {
-        "group_name_attribute": {
-          "type": "string"
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
         },
-        "group_search_base_dn": {
+        "creation_date": {
           "type": "string"
         },
-        "group_search_filter": {
-          "type": "string"
+        "encryption": {
+          "$ref": "#/definitions/bucketEncryption"
         },
-        "server_insecure": {
-          "type": "boolean"
+        "name": {
+          "type": "string",
+          "minLength": 3
         },
-        "skip_tls_verification": {
+        "object_locking": {
           "type": "boolean"
         },
-        "url": {
-          "type": "string"
+        "quota": {
+          "$ref": "#/definitions/bucketQuota"
         },
-        "user_search_filter": {
-          "type": "string"
+        "retention": {
+          "$ref": "#/definitions/bucketRetention"
         },
-        "username_format": {
-          "type": "string"
-        }
-      }
-    },
-    "IdpConfigurationOidc": {
-      "type": "object",
-      "required": [
-        "url",
-        "client_id",
-        "secret_id"
-      ],
-      "properties": {
-        "client_id": {
-          "type": "string"
+        "size": {
+          "type": "integer",
+          "format": "int64"
         },
-        "secret_id": {
-          "type": "string"
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         },
-        "url": {
-          "type": "string"
+        "versioning": {
+          "type": "string",
+          "title": "versioning status, Enabled or Suspended, empty if it was never enabled"
         }
       }
     },
-    "NodeSelectorTermMatchExpressionsItems0": {
-      "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
-      "type": "object",
-      "required": [
-        "key",
-        "operator"
-      ],
+    "bucketAccess": {
+      "type": "string",
+      "default": "PRIVATE",
+      "enum": [
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
+      ]
+    },
+    "bucketAnonymousAccessResponse": {
+      "type": "object",
       "properties": {
-        "key": {
-          "description": "The label key that the selector applies to.",
-          "type": "string"
-        },
-        "operator": {
-          "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
-          "type": "string"
-        },
-        "values": {
-          "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+        "rules": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/anonymousAccessRule"
           }
         }
       }
     },
-    "NodeSelectorTermMatchFieldsItems0": {
-      "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+    "bucketEncryption": {
       "type": "object",
       "required": [
-        "key",
-        "operator"
+        "type"
       ],
       "properties": {
-        "key": {
-          "description": "The label key that the selector applies to.",
-          "type": "string"
-        },
-        "operator": {
-          "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
-          "type": "string"
+        "kms_key_id": {
+          "type": "string",
+          "title": "KMS master key used by sse-kms"
         },
-        "values": {
-          "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "type": {
+          "type": "string",
+          "enum": [
+            "sse-s3",
+            "sse-kms"
+          ]
         }
       }
     },
-    "PodAffinityTermLabelSelector": {
-      "description": "A label query over a set of resources, in this case pods.",
+    "bucketEventRequest": {
       "type": "object",
+      "required": [
+        "configuration"
+      ],
       "properties": {
-        "matchExpressions": {
-          "description": "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/PodAffinityTermLabelSelectorMatchExpressionsItems0"
-          }
+        "configuration": {
+          "$ref": "#/definitions/notificationConfig"
         },
-        "matchLabels": {
-          "description": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "ignoreExisting": {
+          "type": "boolean"
         }
       }
     },
-    "PodAffinityTermLabelSelectorMatchExpressionsItems0": {
-      "description": "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+    "bucketLifecycleResponse": {
       "type": "object",
-      "required": [
-        "key",
-        "operator"
-      ],
       "properties": {
-        "key": {
-          "description": "key is the label key that the selector applies to.",
-          "type": "string"
-        },
-        "operator": {
-          "description": "operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.",
-          "type": "string"
-        },
-        "values": {
-          "description": "values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.",
+        "rules": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/lifecycleRule"
           }
         }
       }
     },
-    "VaultConfigurationApprole": {
+    "bucketObject": {
       "type": "object",
-      "required": [
-        "id",
-        "secret"
-      ],
       "properties": {
-        "engine": {
+        "content_type": {
           "type": "string"
         },
-        "id": {
+        "etag": {
           "type": "string"
         },
-        "retry": {
+        "is_prefix": {
+          "type": "boolean",
+          "title": "true when the entry is a common prefix (folder) instead of an object"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
           "type": "integer",
           "format": "int64"
         },
-        "secret": {
+        "storage_class": {
           "type": "string"
         }
       }
     },
-    "VaultConfigurationStatus": {
+    "bucketPolicyDocument": {
       "type": "object",
       "properties": {
-        "ping": {
-          "type": "integer",
-          "format": "int64"
+        "policy": {
+          "type": "string",
+          "title": "bucket policy as a JSON document, empty when the bucket has no policy"
         }
       }
     },
-    "VaultConfigurationTLS": {
+    "bucketPolicyError": {
       "type": "object",
       "properties": {
-        "ca": {
-          "type": "string"
+        "column": {
+          "type": "integer",
+          "format": "int64"
         },
-        "crt": {
-          "type": "string"
+        "line": {
+          "type": "integer",
+          "format": "int64"
         },
-        "key": {
+        "message": {
           "type": "string"
         }
       }
     },
-    "ZoneAffinityNodeAffinity": {
-      "description": "Describes node affinity scheduling rules for the pod.",
+    "bucketPolicyValidationErrors": {
       "type": "object",
       "properties": {
-        "preferredDuringSchedulingIgnoredDuringExecution": {
-          "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.",
+        "errors": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/ZoneAffinityNodeAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0"
-          }
-        },
-        "requiredDuringSchedulingIgnoredDuringExecution": {
-          "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.",
-          "type": "object",
-          "required": [
-            "nodeSelectorTerms"
-          ],
-          "properties": {
-            "nodeSelectorTerms": {
-              "description": "Required. A list of node selector terms. The terms are ORed.",
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/nodeSelectorTerm"
-              }
-            }
+            "$ref": "#/definitions/bucketPolicyError"
           }
         }
       }
     },
-    "ZoneAffinityNodeAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0": {
-      "description": "An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).",
+    "bucketQuota": {
       "type": "object",
-      "required": [
-        "preference",
-        "weight"
-      ],
       "properties": {
-        "preference": {
-          "description": "A node selector term, associated with the corresponding weight.",
-          "type": "object",
-          "$ref": "#/definitions/nodeSelectorTerm"
-        },
-        "weight": {
-          "description": "Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.",
+        "quota": {
           "type": "integer",
-          "format": "int32"
+          "format": "int64",
+          "title": "quota in bytes, 0 removes the quota of the bucket",
+          "minimum": 0
+        },
+        "type": {
+          "type": "string",
+          "title": "hard quotas reject new writes, fifo quotas remove the oldest objects",
+          "enum": [
+            "hard",
+            "fifo"
+          ]
         }
       }
     },
-    "ZoneAffinityNodeAffinityRequiredDuringSchedulingIgnoredDuringExecution": {
-      "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.",
+    "bucketQuotaUsage": {
       "type": "object",
-      "required": [
-        "nodeSelectorTerms"
-      ],
       "properties": {
-        "nodeSelectorTerms": {
-          "description": "Required. A list of node selector terms. The terms are ORed.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/nodeSelectorTerm"
-          }
+        "error": {
+          "type": "string",
+          "title": "set if the quota of the bucket couldn't be read"
+        },
+        "exceeded": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "quota": {
+          "type": "integer",
+          "format": "int64",
+          "title": "quota in bytes, 0 if the bucket has no quota"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "type": "string"
         }
       }
     },
-    "ZoneAffinityPodAffinity": {
-      "description": "Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).",
+    "bucketReplicationResponse": {
       "type": "object",
       "properties": {
-        "preferredDuringSchedulingIgnoredDuringExecution": {
-          "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ZoneAffinityPodAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0"
-          }
-        },
-        "requiredDuringSchedulingIgnoredDuringExecution": {
-          "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
+        "rules": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/podAffinityTerm"
+            "$ref": "#/definitions/bucketReplicationRule"
           }
         }
       }
     },
-    "ZoneAffinityPodAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0": {
-      "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
+    "bucketReplicationRule": {
       "type": "object",
-      "required": [
-        "podAffinityTerm",
-        "weight"
-      ],
       "properties": {
-        "podAffinityTerm": {
-          "$ref": "#/definitions/podAffinityTerm"
+        "arn": {
+          "type": "string",
+          "title": "ARN of the remote bucket target, all the rules of a bucket share the same target"
         },
-        "weight": {
-          "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
+        "destination_bucket": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "title": "unique identifier of the rule, generated when empty"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
           "type": "integer",
-          "format": "int32"
+          "format": "int32",
+          "title": "rules with higher priority win when several match an object, assigned when empty"
+        },
+        "status": {
+          "type": "string",
+          "title": "defaults to Enabled",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "storage_class": {
+          "type": "string",
+          "title": "storage class of the replicated objects"
+        },
+        "tags": {
+          "type": "string",
+          "title": "tags filter formatted as key1=value1\u0026key2=value2"
         }
       }
     },
-    "ZoneAffinityPodAntiAffinity": {
-      "description": "Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).",
+    "bucketRetention": {
       "type": "object",
+      "required": [
+        "mode",
+        "validity"
+      ],
       "properties": {
-        "preferredDuringSchedulingIgnoredDuringExecution": {
-          "description": "The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ZoneAffinityPodAntiAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0"
-          }
+        "mode": {
+          "type": "string",
+          "enum": [
+            "governance",
+            "compliance"
+          ]
         },
-        "requiredDuringSchedulingIgnoredDuringExecution": {
-          "description": "If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/podAffinityTerm"
-          }
+        "unit": {
+          "type": "string",
+          "title": "unit of the validity, days if not set",
+          "enum": [
+            "days",
+            "years"
+          ]
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
         }
       }
     },
-    "ZoneAffinityPodAntiAffinityPreferredDuringSchedulingIgnoredDuringExecutionItems0": {
-      "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
+    "bucketTags": {
       "type": "object",
-      "required": [
-        "podAffinityTerm",
-        "weight"
-      ],
       "properties": {
-        "podAffinityTerm": {
-          "$ref": "#/definitions/podAffinityTerm"
-        },
-        "weight": {
-          "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
-          "type": "integer",
-          "format": "int32"
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         }
       }
     },
-    "ZoneTolerationsItems0": {
-      "description": "The pod this Toleration is attached to tolerates any taint that matches the triple \u003ckey,value,effect\u003e using the matching operator \u003coperator\u003e.",
+    "bulkObjectResult": {
       "type": "object",
       "properties": {
-        "effect": {
-          "description": "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+        "error": {
           "type": "string"
         },
         "key": {
-          "description": "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
           "type": "string"
         },
-        "operator": {
-          "description": "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
-          "type": "string"
+        "line": {
+          "type": "integer",
+          "format": "int64",
+          "title": "position of the entry in the manifest, starting at 1"
         },
-        "tolerationSeconds": {
-          "$ref": "#/definitions/zoneTolerationSeconds"
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failed"
+          ]
         },
-        "value": {
-          "description": "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
+        "version_id": {
           "type": "string"
         }
       }
     },
-    "ZoneVolumeConfiguration": {
+    "bulkObjectsRequest": {
       "type": "object",
       "required": [
-        "size"
+        "action",
+        "manifest"
       ],
       "properties": {
-        "size": {
-          "type": "integer"
+        "action": {
+          "type": "string",
+          "enum": [
+            "delete",
+            "copy",
+            "set_tags",
+            "set_legal_hold"
+          ]
         },
-        "storage_class_name": {
-          "type": "string"
+        "concurrency": {
+          "type": "integer",
+          "format": "int32",
+          "title": "number of objects processed at the same time, 8 if not set"
+        },
+        "destination_bucket": {
+          "type": "string",
+          "title": "bucket objects are copied to keeping their names, required for copy"
+        },
+        "legal_hold": {
+          "type": "string",
+          "title": "legal hold status set on every object, required for set_legal_hold",
+          "enum": [
+            "enabled",
+            "disabled"
+          ]
+        },
+        "manifest": {
+          "type": "string",
+          "title": "CSV or JSON list of objects"
+        },
+        "manifest_format": {
+          "type": "string",
+          "title": "format of the manifest, detected from its content if not set",
+          "enum": [
+            "csv",
+            "json"
+          ]
+        },
+        "manifest_header": {
+          "type": "boolean",
+          "title": "the first line of a CSV manifest is a header and is skipped"
+        },
+        "tags": {
+          "type": "object",
+          "title": "tags set on every object, required for set_tags, an empty set removes the tags",
+          "additionalProperties": {
+            "type": "string"
+          }
         }
       }
     },
-    "addGroupRequest": {
+    "bulkObjectsResponse": {
       "type": "object",
-      "required": [
-        "group",
-        "members"
-      ],
       "properties": {
-        "group": {
+        "action": {
           "type": "string"
         },
-        "members": {
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "results": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/bulkObjectResult"
           }
-        }
-      }
-    },
-    "addPolicyRequest": {
-      "type": "object",
-      "required": [
-        "name",
-        "policy"
-      ],
-      "properties": {
-        "name": {
-          "type": "string"
         },
-        "policy": {
-          "type": "string"
+        "succeeded": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "addUserRequest": {
+    "bulkUserGroups": {
       "type": "object",
       "required": [
-        "accessKey",
-        "secretKey",
+        "users",
         "groups"
       ],
       "properties": {
-        "accessKey": {
-          "type": "string"
-        },
         "groups": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "secretKey": {
-          "type": "string"
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "adminInfoResponse": {
+    "configDescription": {
       "type": "object",
       "properties": {
-        "buckets": {
-          "type": "integer"
-        },
-        "objects": {
-          "type": "integer"
+        "description": {
+          "type": "string"
         },
-        "usage": {
-          "type": "integer"
+        "key": {
+          "type": "string"
         }
       }
     },
-    "arnsResponse": {
+    "configuration": {
       "type": "object",
       "properties": {
-        "arns": {
+        "key_values": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/configurationKV"
           }
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "awsConfiguration": {
+    "configurationKV": {
       "type": "object",
-      "required": [
-        "secretsmanager"
-      ],
       "properties": {
-        "secretsmanager": {
-          "type": "object",
-          "required": [
-            "endpoint",
-            "region",
-            "credentials"
-          ],
-          "properties": {
-            "credentials": {
-              "type": "object",
-              "required": [
-                "accesskey",
-                "secretkey"
-              ],
-              "properties": {
-                "accesskey": {
-                  "type": "string"
-                },
-                "secretkey": {
-                  "type": "string"
-                },
-                "token": {
-                  "type": "string"
-                }
-              }
-            },
-            "endpoint": {
-              "type": "string"
-            },
-            "kmskey": {
-              "type": "string"
-            },
-            "region": {
-              "type": "string"
-            }
-          }
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
         }
       }
     },
-    "bucket": {
+    "copyObjectsRequest": {
       "type": "object",
       "required": [
-        "name"
+        "source",
+        "destination"
       ],
       "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
-        },
-        "creation_date": {
-          "type": "string"
+        "destination": {
+          "type": "string",
+          "title": "object name, or prefix ending with a slash"
         },
-        "name": {
+        "destination_bucket": {
           "type": "string",
-          "minLength": 3
+          "title": "bucket to copy to, the source bucket if not set"
         },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
-    },
-    "bucketEventRequest": {
-      "type": "object",
-      "required": [
-        "configuration"
-      ],
-      "properties": {
-        "configuration": {
-          "$ref": "#/definitions/notificationConfig"
+        "move": {
+          "type": "boolean",
+          "title": "removes the sources once every object was copied"
         },
-        "ignoreExisting": {
-          "type": "boolean"
+        "source": {
+          "type": "string",
+          "title": "object name, or prefix ending with a slash"
         }
       }
     },
-    "bulkUserGroups": {
+    "copyOperation": {
       "type": "object",
-      "required": [
-        "users",
-        "groups"
-      ],
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "copied": {
+          "type": "integer",
+          "format": "int64"
         },
-        "users": {
+        "deleted": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "destination": {
+          "type": "string"
+        },
+        "destination_bucket": {
+          "type": "string"
+        },
+        "errors": {
           "type": "array",
           "items": {
             "type": "string"
           }
-        }
-      }
-    },
-    "configDescription": {
-      "type": "object",
-      "properties": {
-        "description": {
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "finished_at": {
           "type": "string"
         },
-        "key": {
+        "id": {
+          "type": "string"
+        },
+        "move": {
+          "type": "boolean"
+        },
+        "source": {
           "type": "string"
+        },
+        "source_bucket": {
+          "type": "string"
+        },
+        "started_at": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ]
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of objects found so far"
         }
       }
     },
-    "configuration": {
+    "createFolderRequest": {
       "type": "object",
+      "required": [
+        "path"
+      ],
       "properties": {
-        "key_values": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configurationKV"
-          }
-        },
-        "name": {
-          "type": "string"
+        "path": {
+          "type": "string",
+          "title": "path of the folder, a trailing slash is added if missing"
         }
       }
     },
-    "configurationKV": {
+    "createRemoteBucket": {
       "type": "object",
+      "required": [
+        "source_bucket",
+        "target_bucket",
+        "endpoint",
+        "access_key",
+        "secret_key"
+      ],
       "properties": {
-        "key": {
-          "type": "string"
+        "access_key": {
+          "type": "string",
+          "minLength": 3
         },
-        "value": {
+        "endpoint": {
+          "type": "string",
+          "title": "host and port of the remote deployment e.g. minio2:9000",
+          "minLength": 1
+        },
+        "region": {
           "type": "string"
+        },
+        "secret_key": {
+          "type": "string",
+          "minLength": 8
+        },
+        "secure": {
+          "type": "boolean",
+          "title": "use TLS to reach the remote deployment"
+        },
+        "source_bucket": {
+          "type": "string",
+          "minLength": 3
+        },
+        "target_bucket": {
+          "type": "string",
+          "minLength": 3
         }
       }
     },
@@ -6032,6 +12343,45 @@ func init() {
         }
       }
     },
+    "effectivePolicyGroup": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "type": "boolean",
+          "title": "false for disabled groups, their policies don't apply to the user"
+        },
+        "name": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "effectivePolicyStatement": {
+      "type": "object",
+      "properties": {
+        "effect": {
+          "type": "string"
+        },
+        "sources": {
+          "type": "array",
+          "title": "every policy having the statement",
+          "items": {
+            "$ref": "#/definitions/policySource"
+          }
+        },
+        "statement": {
+          "type": "string"
+        }
+      }
+    },
     "encryptionConfiguration": {
       "type": "object",
       "properties": {
@@ -6138,8 +12488,186 @@ func init() {
           "type": "string"
         },
         "policy": {
+          "type": "array",
+          "title": "policies attached to the group",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamSnapshot": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamSnapshotGroup"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamSnapshotPolicy"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamSnapshotUser"
+          }
+        },
+        "version": {
+          "type": "integer",
+          "title": "version of the snapshot format"
+        }
+      }
+    },
+    "iamSnapshotChange": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete"
+          ]
+        },
+        "description": {
+          "type": "string"
+        },
+        "entity": {
+          "type": "string",
+          "enum": [
+            "policy",
+            "user",
+            "group"
+          ]
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "changes after a failed one are left pending, every change is pending on dry run",
+          "enum": [
+            "pending",
+            "applied",
+            "failed"
+          ]
+        }
+      }
+    },
+    "iamSnapshotGroup": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "iamSnapshotImportRequest": {
+      "type": "object",
+      "required": [
+        "snapshot"
+      ],
+      "properties": {
+        "dryRun": {
+          "type": "boolean"
+        },
+        "generateSecrets": {
+          "type": "boolean",
+          "title": "snapshots have no secret keys, users missing on the server are created with a generated one if set and fail otherwise"
+        },
+        "snapshot": {
+          "$ref": "#/definitions/iamSnapshot"
+        },
+        "strategy": {
+          "type": "string",
+          "title": "merge keeps entities missing on the snapshot and the policies of users and groups without any, replace removes them",
+          "enum": [
+            "merge",
+            "replace"
+          ]
+        }
+      }
+    },
+    "iamSnapshotImportResponse": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamSnapshotChange"
+          }
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "secretsExpiresAt": {
+          "type": "string"
+        },
+        "secretsToken": {
+          "type": "string",
+          "title": "token to download once the generated secret keys, the same way as the ones of a users import"
+        },
+        "strategy": {
+          "type": "string"
+        }
+      }
+    },
+    "iamSnapshotPolicy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "iamSnapshotUser": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
           "type": "string"
         },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string",
+          "title": "never exported, only required to create users missing on import"
+        },
         "status": {
           "type": "string"
         }
@@ -6203,37 +12731,214 @@ func init() {
         }
       }
     },
-    "imageRegistry": {
+    "imageRegistry": {
+      "type": "object",
+      "required": [
+        "registry",
+        "username",
+        "password"
+      ],
+      "properties": {
+        "password": {
+          "type": "string"
+        },
+        "registry": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "importUserResult": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "line": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "secretGenerated": {
+          "type": "boolean"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "created",
+            "failed",
+            "invalid",
+            "skipped"
+          ]
+        }
+      }
+    },
+    "importUsersRequest": {
+      "type": "object",
+      "required": [
+        "csv"
+      ],
+      "properties": {
+        "concurrency": {
+          "type": "integer",
+          "format": "int32",
+          "title": "number of users created at the same time"
+        },
+        "csv": {
+          "type": "string",
+          "title": "CSV with a header row, columns are accessKey, secretKey, generateSecret, groups, policy and status, a secret is generated for rows without secretKey"
+        }
+      }
+    },
+    "importUsersResponse": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "invalid": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/importUserResult"
+          }
+        },
+        "secretsExpiresAt": {
+          "type": "string"
+        },
+        "secretsToken": {
+          "type": "string",
+          "title": "token to download once the generated secret keys"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "keyPairConfiguration": {
       "type": "object",
       "required": [
-        "registry",
-        "username",
-        "password"
+        "crt",
+        "key"
       ],
       "properties": {
-        "password": {
+        "crt": {
           "type": "string"
         },
-        "registry": {
+        "key": {
           "type": "string"
+        }
+      }
+    },
+    "lifecycleRule": {
+      "type": "object",
+      "properties": {
+        "abort_incomplete_multipart_days": {
+          "type": "integer",
+          "format": "int64",
+          "title": "days after initiation when incomplete multipart uploads are aborted"
         },
-        "username": {
-          "type": "string"
+        "expiration_date": {
+          "type": "string",
+          "title": "date when objects expire, formatted as YYYY-MM-DD"
+        },
+        "expiration_days": {
+          "type": "integer",
+          "format": "int64",
+          "title": "days after creation when objects expire"
+        },
+        "expired_object_delete_marker": {
+          "type": "boolean",
+          "title": "remove delete markers with no noncurrent versions left"
+        },
+        "id": {
+          "type": "string",
+          "title": "unique identifier of the rule, generated when empty"
+        },
+        "noncurrent_expiration_days": {
+          "type": "integer",
+          "format": "int64",
+          "title": "days after becoming noncurrent when versions expire"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "only objects under this prefix are affected"
+        },
+        "status": {
+          "type": "string",
+          "title": "defaults to Enabled",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "tags": {
+          "type": "array",
+          "title": "only objects with all these tags are affected",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
         }
       }
     },
-    "keyPairConfiguration": {
+    "lifecycleRuleErrors": {
       "type": "object",
       "required": [
-        "crt",
-        "key"
+        "index"
       ],
       "properties": {
-        "crt": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
           "type": "string"
         },
+        "index": {
+          "type": "integer",
+          "format": "int64",
+          "title": "position of the rule on the request"
+        }
+      }
+    },
+    "lifecycleTag": {
+      "type": "object",
+      "properties": {
         "key": {
           "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "lifecycleValidationErrors": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "title": "validation errors of every invalid rule",
+          "items": {
+            "$ref": "#/definitions/lifecycleRuleErrors"
+          }
         }
       }
     },
@@ -6253,6 +12958,21 @@ func init() {
         }
       }
     },
+    "listBucketQuotasResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketQuotaUsage"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "listBucketsResponse": {
       "type": "object",
       "properties": {
@@ -6266,7 +12986,7 @@ func init() {
         "total": {
           "type": "integer",
           "format": "int64",
-          "title": "number of buckets accessible to tenant user"
+          "title": "number of buckets accessible to tenant user matching the search before pagination"
         }
       }
     },
@@ -6303,6 +13023,48 @@ func init() {
         }
       }
     },
+    "listObjectVersionsResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of versions"
+        },
+        "versions": {
+          "type": "array",
+          "title": "versions of the object, newest first",
+          "items": {
+            "$ref": "#/definitions/objectVersion"
+          }
+        }
+      }
+    },
+    "listObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "is_truncated": {
+          "type": "boolean",
+          "title": "true when there are more results to fetch"
+        },
+        "next_continuation_token": {
+          "type": "string",
+          "title": "token to send on the next request to fetch the following page"
+        },
+        "objects": {
+          "type": "array",
+          "title": "list of resulting objects",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of objects and prefixes in this page"
+        }
+      }
+    },
     "listPoliciesResponse": {
       "type": "object",
       "properties": {
@@ -6320,6 +13082,40 @@ func init() {
         }
       }
     },
+    "listRemoteBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "title": "list of remote bucket targets",
+          "items": {
+            "$ref": "#/definitions/remoteBucket"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of remote bucket targets"
+        }
+      }
+    },
+    "listSharesResponse": {
+      "type": "object",
+      "properties": {
+        "shares": {
+          "type": "array",
+          "title": "list of links that haven't expired",
+          "items": {
+            "$ref": "#/definitions/shareLink"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of active links"
+        }
+      }
+    },
     "listTenantsResponse": {
       "type": "object",
       "properties": {
@@ -6420,8 +13216,20 @@ func init() {
         "name"
       ],
       "properties": {
+        "encryption": {
+          "$ref": "#/definitions/bucketEncryption"
+        },
         "name": {
           "type": "string"
+        },
+        "object_locking": {
+          "type": "boolean"
+        },
+        "quota": {
+          "$ref": "#/definitions/bucketQuota"
+        },
+        "retention": {
+          "$ref": "#/definitions/bucketRetention"
         }
       }
     },
@@ -6500,18 +13308,118 @@ func init() {
         }
       }
     },
-    "notificationDeleteRequest": {
+    "notificationDeleteRequest": {
+      "type": "object",
+      "required": [
+        "events",
+        "prefix",
+        "suffix"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
+        }
+      }
+    },
+    "notificationEndpoint": {
+      "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        }
+      }
+    },
+    "notificationEndpointItem": {
+      "type": "object",
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "notificationEventType": {
+      "type": "string",
+      "enum": [
+        "put",
+        "delete",
+        "get"
+      ]
+    },
+    "notificationTestResult": {
+      "type": "object",
+      "properties": {
+        "events_published": {
+          "type": "array",
+          "title": "events of the probe object seen on the MinIO listen API, they don't prove the target received them and events published before the listener attached are missed",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "events_sent": {
+          "type": "array",
+          "title": "events triggered on the probe object",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "object": {
+          "type": "string",
+          "title": "name of the probe object"
+        },
+        "success": {
+          "type": "boolean",
+          "title": "every event was seen on the listen API and the target is online"
+        },
+        "target_status": {
+          "type": "string",
+          "title": "status of the target reported by MinIO",
+          "enum": [
+            "online",
+            "offline",
+            "unknown"
+          ]
+        }
+      }
+    },
+    "notificationUpdateRequest": {
       "type": "object",
-      "required": [
-        "events",
-        "prefix",
-        "suffix"
-      ],
       "properties": {
         "events": {
           "type": "array",
           "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "minLength": 1,
           "items": {
             "$ref": "#/definitions/notificationEventType"
           }
@@ -6526,49 +13434,146 @@ func init() {
         }
       }
     },
-    "notificationEndpoint": {
+    "objectMetadata": {
       "type": "object",
-      "required": [
-        "service",
-        "account_id",
-        "properties"
-      ],
       "properties": {
-        "account_id": {
+        "content_type": {
           "type": "string"
         },
-        "properties": {
+        "etag": {
+          "type": "string"
+        },
+        "headers": {
           "type": "object",
+          "title": "system headers of the object",
           "additionalProperties": {
             "type": "string"
           }
         },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold": {
+          "type": "string",
+          "title": "legal hold status, ON or OFF, empty if it was never set"
+        },
+        "name": {
+          "type": "string"
+        },
+        "retain_until": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_metadata": {
+          "type": "object",
+          "title": "user-defined metadata without the x-amz-meta- prefix",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "version_id": {
+          "type": "string"
         }
       }
     },
-    "notificationEndpointItem": {
+    "objectPreview": {
       "type": "object",
       "properties": {
-        "account_id": {
+        "content": {
+          "type": "string",
+          "title": "beginning of the object for text and json objects"
+        },
+        "content_type": {
+          "type": "string",
+          "title": "content type stored along with the object"
+        },
+        "detected_content_type": {
+          "type": "string",
+          "title": "content type detected from the object data"
+        },
+        "etag": {
           "type": "string"
         },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
+        "kind": {
+          "type": "string",
+          "enum": [
+            "text",
+            "json",
+            "image",
+            "binary"
+          ]
         },
-        "status": {
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean",
+          "title": "set when content doesn't hold the whole object"
+        },
+        "version_id": {
           "type": "string"
         }
       }
     },
-    "notificationEventType": {
-      "type": "string",
-      "enum": [
-        "put",
-        "delete",
-        "get"
-      ]
+    "objectTags": {
+      "type": "object",
+      "properties": {
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "objectVersion": {
+      "type": "object",
+      "properties": {
+        "etag": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
     },
     "podAffinityTerm": {
       "description": "Required. A pod affinity term, associated with the corresponding weight.",
@@ -6629,46 +13634,218 @@ func init() {
         "group"
       ]
     },
+    "policySimulationRequest": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityName",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "title": "action to evaluate, e.g. s3:GetObject"
+        },
+        "conditions": {
+          "type": "object",
+          "title": "condition values such as aws:SourceIp",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount"
+          ]
+        },
+        "parentUser": {
+          "type": "string",
+          "title": "user owning the service account, required for service accounts and must be the logged in user"
+        },
+        "resource": {
+          "type": "string",
+          "title": "resource ARN, e.g. arn:aws:s3:::bucket/object, or bucket/object"
+        },
+        "sessionPolicy": {
+          "type": "string",
+          "title": "policy the service account was created with, MinIO doesn't return it so it must be provided"
+        }
+      }
+    },
+    "policySimulationResponse": {
+      "type": "object",
+      "properties": {
+        "decision": {
+          "type": "string",
+          "enum": [
+            "allow",
+            "deny"
+          ]
+        },
+        "matchedStatements": {
+          "type": "array",
+          "title": "statements that decided the result, empty when nothing allows the action",
+          "items": {
+            "$ref": "#/definitions/policyStatementMatch"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "title": "policies evaluated",
+          "items": {
+            "type": "string"
+          }
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "policySource": {
+      "type": "object",
+      "properties": {
+        "group": {
+          "type": "string",
+          "title": "group the policy is set on, empty if it's set on the user"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "policyStatementMatch": {
+      "type": "object",
+      "properties": {
+        "effect": {
+          "type": "string"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int64",
+          "title": "position of the statement in the policy, starting at 0"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "statement": {
+          "type": "string"
+        }
+      }
+    },
     "principal": {
       "type": "object",
       "properties": {
         "accessKeyID": {
           "type": "string"
         },
-        "actions": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "actions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretAccessKey": {
+          "type": "string"
+        },
+        "sessionToken": {
+          "type": "string"
+        }
+      }
+    },
+    "profilerType": {
+      "type": "string",
+      "enum": [
+        "cpu",
+        "mem",
+        "block",
+        "mutex",
+        "trace",
+        "threads",
+        "goroutines"
+      ]
+    },
+    "profilingStartRequest": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "type": {
+          "$ref": "#/definitions/profilerType"
+        }
+      }
+    },
+    "putObjectLegalHoldRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "enabled",
+            "disabled"
+          ]
+        }
+      }
+    },
+    "putObjectRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "retain_until"
+      ],
+      "properties": {
+        "governance_bypass": {
+          "type": "boolean",
+          "title": "allows to shorten or remove a governance retention"
+        },
+        "mode": {
+          "type": "string",
+          "enum": [
+            "governance",
+            "compliance"
+          ]
+        },
+        "retain_until": {
+          "type": "string",
+          "title": "RFC3339 date until the object is retained"
+        }
+      }
+    },
+    "remoteBucket": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "arn": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
         },
-        "secretAccessKey": {
+        "secure": {
+          "type": "boolean"
+        },
+        "service": {
           "type": "string"
         },
-        "sessionToken": {
+        "source_bucket": {
+          "type": "string"
+        },
+        "target_bucket": {
           "type": "string"
-        }
-      }
-    },
-    "profilerType": {
-      "type": "string",
-      "enum": [
-        "cpu",
-        "mem",
-        "block",
-        "mutex",
-        "trace",
-        "threads",
-        "goroutines"
-      ]
-    },
-    "profilingStartRequest": {
-      "type": "object",
-      "required": [
-        "type"
-      ],
-      "properties": {
-        "type": {
-          "$ref": "#/definitions/profilerType"
         }
       }
     },
@@ -6702,6 +13879,127 @@ func init() {
         }
       }
     },
+    "selectInputSerialization": {
+      "type": "object",
+      "required": [
+        "format"
+      ],
+      "properties": {
+        "compression": {
+          "type": "string",
+          "enum": [
+            "none",
+            "gzip",
+            "bzip2"
+          ]
+        },
+        "csv_comments": {
+          "type": "string",
+          "title": "lines starting with this character are skipped"
+        },
+        "csv_field_delimiter": {
+          "type": "string"
+        },
+        "csv_header": {
+          "type": "string",
+          "title": "how the first line of a CSV object is handled, use if not set",
+          "enum": [
+            "use",
+            "ignore",
+            "none"
+          ]
+        },
+        "csv_quote_character": {
+          "type": "string"
+        },
+        "format": {
+          "type": "string",
+          "enum": [
+            "csv",
+            "json",
+            "parquet"
+          ]
+        },
+        "json_type": {
+          "type": "string",
+          "title": "lines if not set",
+          "enum": [
+            "lines",
+            "document"
+          ]
+        }
+      }
+    },
+    "selectObjectEvent": {
+      "type": "object",
+      "properties": {
+        "bytes_processed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytes_returned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytes_scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "type": "string"
+        },
+        "records": {
+          "type": "string"
+        },
+        "truncated": {
+          "type": "boolean",
+          "title": "set on the stats event when the row limit was reached"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "records",
+            "progress",
+            "stats",
+            "error"
+          ]
+        }
+      }
+    },
+    "selectObjectRequest": {
+      "type": "object",
+      "required": [
+        "expression",
+        "input"
+      ],
+      "properties": {
+        "expression": {
+          "type": "string",
+          "title": "SQL expression, the object is referenced as S3Object"
+        },
+        "input": {
+          "$ref": "#/definitions/selectInputSerialization"
+        },
+        "output_format": {
+          "type": "string",
+          "title": "format of the returned records, json if not set",
+          "enum": [
+            "json",
+            "csv"
+          ]
+        },
+        "request_progress": {
+          "type": "boolean",
+          "title": "sends progress events while the object is scanned"
+        },
+        "row_limit": {
+          "type": "integer",
+          "format": "int64",
+          "title": "maximum number of records returned, 1000 if not set",
+          "minimum": 0
+        }
+      }
+    },
     "serviceAccountCreds": {
       "type": "object",
       "properties": {
@@ -6745,6 +14043,17 @@ func init() {
         }
       }
     },
+    "setBucketLifecycleRequest": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleRule"
+          }
+        }
+      }
+    },
     "setBucketPolicyRequest": {
       "type": "object",
       "required": [
@@ -6756,6 +14065,21 @@ func init() {
         }
       }
     },
+    "setBucketVersioningRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Suspended"
+          ]
+        }
+      }
+    },
     "setConfigRequest": {
       "type": "object",
       "required": [
@@ -6787,6 +14111,97 @@ func init() {
         },
         "entityType": {
           "$ref": "#/definitions/policyEntity"
+        },
+        "mode": {
+          "type": "string",
+          "title": "replace attaches only the given policies, add and remove keep the rest of the attached policies",
+          "enum": [
+            "replace",
+            "add",
+            "remove"
+          ]
+        },
+        "policies": {
+          "type": "array",
+          "title": "policies set along with the ones named on the path",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "shareLink": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string"
+        },
+        "created_by": {
+          "type": "string",
+          "title": "access key of the session that generated the link"
+        },
+        "expires_at": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "object": {
+          "type": "string"
+        }
+      }
+    },
+    "shareObjectRequest": {
+      "type": "object",
+      "required": [
+        "prefix"
+      ],
+      "properties": {
+        "expires": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds until the link expires, defaults to 7 days",
+          "maximum": 604800,
+          "minimum": 1
+        },
+        "method": {
+          "type": "string",
+          "title": "GET links download the object, PUT links upload it, defaults to GET",
+          "enum": [
+            "GET",
+            "PUT"
+          ]
+        },
+        "prefix": {
+          "type": "string",
+          "title": "name of the object to share"
+        },
+        "response_headers": {
+          "type": "object",
+          "title": "response headers overridden on GET links e.g. content-disposition",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "shareObjectResponse": {
+      "type": "object",
+      "properties": {
+        "expires_at": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
         }
       }
     },
@@ -6974,6 +14389,53 @@ func init() {
         }
       }
     },
+    "uploadObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of files that couldn't be uploaded"
+        },
+        "objects": {
+          "type": "array",
+          "title": "result of every file sent on the request",
+          "items": {
+            "$ref": "#/definitions/uploadedObject"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of files received"
+        }
+      }
+    },
+    "uploadedObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string",
+          "title": "reason why the object couldn't be uploaded, empty on success"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
     "user": {
       "type": "object",
       "properties": {
@@ -6987,8 +14449,46 @@ func init() {
           }
         },
         "policy": {
+          "type": "array",
+          "title": "policies attached to the user",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "userEffectivePolicy": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
           "type": "string"
         },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/effectivePolicyGroup"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "title": "policies applying to the user",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "string",
+          "title": "effective policy merging every policy applying to the user"
+        },
+        "statements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/effectivePolicyStatement"
+          }
+        },
         "status": {
           "type": "string"
         }
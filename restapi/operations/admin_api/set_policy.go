@@ -50,7 +50,7 @@ func NewSetPolicy(ctx *middleware.Context, handler SetPolicyHandler) *SetPolicy
 
 /*SetPolicy swagger:route PUT /set-policy/{name} AdminAPI setPolicy
 
-Set policy
+Attaches or detaches policies of a user or group, the path accepts a comma separated list
 
 */
 type SetPolicy struct {
@@ -58,11 +58,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
-		JSONConsumer: runtime.JSONConsumer(),
+		JSONConsumer:          runtime.JSONConsumer(),
+		MultipartformConsumer: runtime.DiscardConsumer,
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
 
+		UserAPIAddBucketLifecycleRuleHandler: user_api.AddBucketLifecycleRuleHandlerFunc(func(params user_api.AddBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.AddBucketLifecycleRule has not yet been implemented")
+		}),
+		UserAPIAddBucketReplicationRuleHandler: user_api.AddBucketReplicationRuleHandlerFunc(func(params user_api.AddBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.AddBucketReplicationRule has not yet been implemented")
+		}),
 		AdminAPIAddGroupHandler: admin_api.AddGroupHandlerFunc(func(params admin_api.AddGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.AddGroup has not yet been implemented")
 		}),
@@ -72,6 +79,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIAddPolicyHandler: admin_api.AddPolicyHandlerFunc(func(params admin_api.AddPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.AddPolicy has not yet been implemented")
 		}),
+		AdminAPIAddRemoteBucketHandler: admin_api.AddRemoteBucketHandlerFunc(func(params admin_api.AddRemoteBucketParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.AddRemoteBucket has not yet been implemented")
+		}),
 		AdminAPIAddUserHandler: admin_api.AddUserHandlerFunc(func(params admin_api.AddUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.AddUser has not yet been implemented")
 		}),
@@ -87,15 +97,24 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIBucketSetPolicyHandler: user_api.BucketSetPolicyHandlerFunc(func(params user_api.BucketSetPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.BucketSetPolicy has not yet been implemented")
 		}),
+		UserAPIBulkObjectsHandler: user_api.BulkObjectsHandlerFunc(func(params user_api.BulkObjectsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.BulkObjects has not yet been implemented")
+		}),
 		AdminAPIBulkUpdateUsersGroupsHandler: admin_api.BulkUpdateUsersGroupsHandlerFunc(func(params admin_api.BulkUpdateUsersGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.BulkUpdateUsersGroups has not yet been implemented")
 		}),
 		AdminAPIConfigInfoHandler: admin_api.ConfigInfoHandlerFunc(func(params admin_api.ConfigInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ConfigInfo has not yet been implemented")
 		}),
+		UserAPICopyObjectsHandler: user_api.CopyObjectsHandlerFunc(func(params user_api.CopyObjectsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.CopyObjects has not yet been implemented")
+		}),
 		UserAPICreateBucketEventHandler: user_api.CreateBucketEventHandlerFunc(func(params user_api.CreateBucketEventParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.CreateBucketEvent has not yet been implemented")
 		}),
+		UserAPICreateFolderHandler: user_api.CreateFolderHandlerFunc(func(params user_api.CreateFolderParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.CreateFolder has not yet been implemented")
+		}),
 		UserAPICreateServiceAccountHandler: user_api.CreateServiceAccountHandlerFunc(func(params user_api.CreateServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.CreateServiceAccount has not yet been implemented")
 		}),
@@ -108,30 +127,96 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIDeleteBucketEventHandler: user_api.DeleteBucketEventHandlerFunc(func(params user_api.DeleteBucketEventParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DeleteBucketEvent has not yet been implemented")
 		}),
+		UserAPIDeleteBucketLifecycleRuleHandler: user_api.DeleteBucketLifecycleRuleHandlerFunc(func(params user_api.DeleteBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.DeleteBucketLifecycleRule has not yet been implemented")
+		}),
+		UserAPIDeleteBucketReplicationRuleHandler: user_api.DeleteBucketReplicationRuleHandlerFunc(func(params user_api.DeleteBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.DeleteBucketReplicationRule has not yet been implemented")
+		}),
+		UserAPIDeleteBucketTagsHandler: user_api.DeleteBucketTagsHandlerFunc(func(params user_api.DeleteBucketTagsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.DeleteBucketTags has not yet been implemented")
+		}),
+		AdminAPIDeleteRemoteBucketHandler: admin_api.DeleteRemoteBucketHandlerFunc(func(params admin_api.DeleteRemoteBucketParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteRemoteBucket has not yet been implemented")
+		}),
 		UserAPIDeleteServiceAccountHandler: user_api.DeleteServiceAccountHandlerFunc(func(params user_api.DeleteServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DeleteServiceAccount has not yet been implemented")
 		}),
 		AdminAPIDeleteTenantHandler: admin_api.DeleteTenantHandlerFunc(func(params admin_api.DeleteTenantParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.DeleteTenant has not yet been implemented")
 		}),
+		UserAPIDisableBucketEncryptionHandler: user_api.DisableBucketEncryptionHandlerFunc(func(params user_api.DisableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.DisableBucketEncryption has not yet been implemented")
+		}),
+		AdminAPIDownloadImportedUsersSecretsHandler: admin_api.DownloadImportedUsersSecretsHandlerFunc(func(params admin_api.DownloadImportedUsersSecretsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DownloadImportedUsersSecrets has not yet been implemented")
+		}),
+		UserAPIDownloadObjectHandler: user_api.DownloadObjectHandlerFunc(func(params user_api.DownloadObjectParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.DownloadObject has not yet been implemented")
+		}),
+		UserAPIDownloadObjectsZipHandler: user_api.DownloadObjectsZipHandlerFunc(func(params user_api.DownloadObjectsZipParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.DownloadObjectsZip has not yet been implemented")
+		}),
+		AdminAPIExportIamSnapshotHandler: admin_api.ExportIamSnapshotHandlerFunc(func(params admin_api.ExportIamSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ExportIamSnapshot has not yet been implemented")
+		}),
+		AdminAPIExportUsersHandler: admin_api.ExportUsersHandlerFunc(func(params admin_api.ExportUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ExportUsers has not yet been implemented")
+		}),
+		UserAPIGetBucketLifecycleHandler: user_api.GetBucketLifecycleHandlerFunc(func(params user_api.GetBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetBucketLifecycle has not yet been implemented")
+		}),
+		UserAPIGetBucketPolicyDocumentHandler: user_api.GetBucketPolicyDocumentHandlerFunc(func(params user_api.GetBucketPolicyDocumentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetBucketPolicyDocument has not yet been implemented")
+		}),
+		UserAPIGetBucketReplicationHandler: user_api.GetBucketReplicationHandlerFunc(func(params user_api.GetBucketReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetBucketReplication has not yet been implemented")
+		}),
+		UserAPIGetBucketTagsHandler: user_api.GetBucketTagsHandlerFunc(func(params user_api.GetBucketTagsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetBucketTags has not yet been implemented")
+		}),
+		UserAPIGetCopyOperationHandler: user_api.GetCopyOperationHandlerFunc(func(params user_api.GetCopyOperationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetCopyOperation has not yet been implemented")
+		}),
+		UserAPIGetObjectMetadataHandler: user_api.GetObjectMetadataHandlerFunc(func(params user_api.GetObjectMetadataParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetObjectMetadata has not yet been implemented")
+		}),
+		UserAPIGetObjectTagsHandler: user_api.GetObjectTagsHandlerFunc(func(params user_api.GetObjectTagsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetObjectTags has not yet been implemented")
+		}),
 		AdminAPIGetResourceQuotaHandler: admin_api.GetResourceQuotaHandlerFunc(func(params admin_api.GetResourceQuotaParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.GetResourceQuota has not yet been implemented")
 		}),
 		AdminAPIGetTenantUsageHandler: admin_api.GetTenantUsageHandlerFunc(func(params admin_api.GetTenantUsageParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.GetTenantUsage has not yet been implemented")
 		}),
+		AdminAPIGetUserEffectivePolicyHandler: admin_api.GetUserEffectivePolicyHandlerFunc(func(params admin_api.GetUserEffectivePolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetUserEffectivePolicy has not yet been implemented")
+		}),
 		AdminAPIGetUserInfoHandler: admin_api.GetUserInfoHandlerFunc(func(params admin_api.GetUserInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.GetUserInfo has not yet been implemented")
 		}),
 		AdminAPIGroupInfoHandler: admin_api.GroupInfoHandlerFunc(func(params admin_api.GroupInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.GroupInfo has not yet been implemented")
 		}),
+		AdminAPIImportIamSnapshotHandler: admin_api.ImportIamSnapshotHandlerFunc(func(params admin_api.ImportIamSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ImportIamSnapshot has not yet been implemented")
+		}),
+		AdminAPIImportUsersHandler: admin_api.ImportUsersHandlerFunc(func(params admin_api.ImportUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ImportUsers has not yet been implemented")
+		}),
 		AdminAPIListAllTenantsHandler: admin_api.ListAllTenantsHandlerFunc(func(params admin_api.ListAllTenantsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListAllTenants has not yet been implemented")
 		}),
+		UserAPIListBucketAnonymousAccessHandler: user_api.ListBucketAnonymousAccessHandlerFunc(func(params user_api.ListBucketAnonymousAccessParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ListBucketAnonymousAccess has not yet been implemented")
+		}),
 		UserAPIListBucketEventsHandler: user_api.ListBucketEventsHandlerFunc(func(params user_api.ListBucketEventsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.ListBucketEvents has not yet been implemented")
 		}),
+		AdminAPIListBucketQuotasHandler: admin_api.ListBucketQuotasHandlerFunc(func(params admin_api.ListBucketQuotasParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListBucketQuotas has not yet been implemented")
+		}),
 		UserAPIListBucketsHandler: user_api.ListBucketsHandlerFunc(func(params user_api.ListBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.ListBuckets has not yet been implemented")
 		}),
@@ -141,9 +226,21 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIListGroupsHandler: admin_api.ListGroupsHandlerFunc(func(params admin_api.ListGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListGroups has not yet been implemented")
 		}),
+		UserAPIListObjectVersionsHandler: user_api.ListObjectVersionsHandlerFunc(func(params user_api.ListObjectVersionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ListObjectVersions has not yet been implemented")
+		}),
+		UserAPIListObjectsHandler: user_api.ListObjectsHandlerFunc(func(params user_api.ListObjectsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ListObjects has not yet been implemented")
+		}),
 		AdminAPIListPoliciesHandler: admin_api.ListPoliciesHandlerFunc(func(params admin_api.ListPoliciesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListPolicies has not yet been implemented")
 		}),
+		AdminAPIListRemoteBucketsHandler: admin_api.ListRemoteBucketsHandlerFunc(func(params admin_api.ListRemoteBucketsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListRemoteBuckets has not yet been implemented")
+		}),
+		AdminAPIListSharesHandler: admin_api.ListSharesHandlerFunc(func(params admin_api.ListSharesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListShares has not yet been implemented")
+		}),
 		AdminAPIListTenantsHandler: admin_api.ListTenantsHandlerFunc(func(params admin_api.ListTenantsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListTenants has not yet been implemented")
 		}),
@@ -177,12 +274,27 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIPolicyInfoHandler: admin_api.PolicyInfoHandlerFunc(func(params admin_api.PolicyInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.PolicyInfo has not yet been implemented")
 		}),
+		UserAPIPreviewObjectHandler: user_api.PreviewObjectHandlerFunc(func(params user_api.PreviewObjectParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.PreviewObject has not yet been implemented")
+		}),
 		AdminAPIProfilingStartHandler: admin_api.ProfilingStartHandlerFunc(func(params admin_api.ProfilingStartParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ProfilingStart has not yet been implemented")
 		}),
 		AdminAPIProfilingStopHandler: admin_api.ProfilingStopHandlerFunc(func(params admin_api.ProfilingStopParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ProfilingStop has not yet been implemented")
 		}),
+		UserAPIPutBucketTagsHandler: user_api.PutBucketTagsHandlerFunc(func(params user_api.PutBucketTagsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.PutBucketTags has not yet been implemented")
+		}),
+		UserAPIPutObjectLegalHoldHandler: user_api.PutObjectLegalHoldHandlerFunc(func(params user_api.PutObjectLegalHoldParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.PutObjectLegalHold has not yet been implemented")
+		}),
+		UserAPIPutObjectRetentionHandler: user_api.PutObjectRetentionHandlerFunc(func(params user_api.PutObjectRetentionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.PutObjectRetention has not yet been implemented")
+		}),
+		UserAPIPutObjectTagsHandler: user_api.PutObjectTagsHandlerFunc(func(params user_api.PutObjectTagsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.PutObjectTags has not yet been implemented")
+		}),
 		AdminAPIRemoveGroupHandler: admin_api.RemoveGroupHandlerFunc(func(params admin_api.RemoveGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.RemoveGroup has not yet been implemented")
 		}),
@@ -195,21 +307,66 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIRestartServiceHandler: admin_api.RestartServiceHandlerFunc(func(params admin_api.RestartServiceParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.RestartService has not yet been implemented")
 		}),
+		UserAPIRestoreObjectVersionHandler: user_api.RestoreObjectVersionHandlerFunc(func(params user_api.RestoreObjectVersionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.RestoreObjectVersion has not yet been implemented")
+		}),
+		UserAPISelectObjectContentHandler: user_api.SelectObjectContentHandlerFunc(func(params user_api.SelectObjectContentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SelectObjectContent has not yet been implemented")
+		}),
 		UserAPISessionCheckHandler: user_api.SessionCheckHandlerFunc(func(params user_api.SessionCheckParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.SessionCheck has not yet been implemented")
 		}),
+		UserAPISetBucketAnonymousAccessHandler: user_api.SetBucketAnonymousAccessHandlerFunc(func(params user_api.SetBucketAnonymousAccessParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketAnonymousAccess has not yet been implemented")
+		}),
+		UserAPISetBucketEncryptionHandler: user_api.SetBucketEncryptionHandlerFunc(func(params user_api.SetBucketEncryptionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketEncryption has not yet been implemented")
+		}),
+		UserAPISetBucketLifecycleHandler: user_api.SetBucketLifecycleHandlerFunc(func(params user_api.SetBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketLifecycle has not yet been implemented")
+		}),
+		UserAPISetBucketPolicyDocumentHandler: user_api.SetBucketPolicyDocumentHandlerFunc(func(params user_api.SetBucketPolicyDocumentParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketPolicyDocument has not yet been implemented")
+		}),
+		UserAPISetBucketQuotaHandler: user_api.SetBucketQuotaHandlerFunc(func(params user_api.SetBucketQuotaParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketQuota has not yet been implemented")
+		}),
+		UserAPISetBucketRetentionHandler: user_api.SetBucketRetentionHandlerFunc(func(params user_api.SetBucketRetentionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketRetention has not yet been implemented")
+		}),
+		UserAPISetBucketVersioningHandler: user_api.SetBucketVersioningHandlerFunc(func(params user_api.SetBucketVersioningParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketVersioning has not yet been implemented")
+		}),
 		AdminAPISetConfigHandler: admin_api.SetConfigHandlerFunc(func(params admin_api.SetConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.SetConfig has not yet been implemented")
 		}),
 		AdminAPISetPolicyHandler: admin_api.SetPolicyHandlerFunc(func(params admin_api.SetPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.SetPolicy has not yet been implemented")
 		}),
+		UserAPIShareObjectHandler: user_api.ShareObjectHandlerFunc(func(params user_api.ShareObjectParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ShareObject has not yet been implemented")
+		}),
+		AdminAPISimulatePolicyHandler: admin_api.SimulatePolicyHandlerFunc(func(params admin_api.SimulatePolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SimulatePolicy has not yet been implemented")
+		}),
 		AdminAPITenantAddZoneHandler: admin_api.TenantAddZoneHandlerFunc(func(params admin_api.TenantAddZoneParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.TenantAddZone has not yet been implemented")
 		}),
 		AdminAPITenantInfoHandler: admin_api.TenantInfoHandlerFunc(func(params admin_api.TenantInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.TenantInfo has not yet been implemented")
 		}),
+		UserAPITestBucketEventHandler: user_api.TestBucketEventHandlerFunc(func(params user_api.TestBucketEventParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.TestBucketEvent has not yet been implemented")
+		}),
+		UserAPIUpdateBucketEventHandler: user_api.UpdateBucketEventHandlerFunc(func(params user_api.UpdateBucketEventParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.UpdateBucketEvent has not yet been implemented")
+		}),
+		UserAPIUpdateBucketLifecycleRuleHandler: user_api.UpdateBucketLifecycleRuleHandlerFunc(func(params user_api.UpdateBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.UpdateBucketLifecycleRule has not yet been implemented")
+		}),
+		UserAPIUpdateBucketReplicationRuleHandler: user_api.UpdateBucketReplicationRuleHandlerFunc(func(params user_api.UpdateBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.UpdateBucketReplicationRule has not yet been implemented")
+		}),
 		AdminAPIUpdateGroupHandler: admin_api.UpdateGroupHandlerFunc(func(params admin_api.UpdateGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.UpdateGroup has not yet been implemented")
 		}),
@@ -222,6 +379,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIUpdateUserInfoHandler: admin_api.UpdateUserInfoHandlerFunc(func(params admin_api.UpdateUserInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.UpdateUserInfo has not yet been implemented")
 		}),
+		UserAPIUploadObjectsHandler: user_api.UploadObjectsHandlerFunc(func(params user_api.UploadObjectsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.UploadObjects has not yet been implemented")
+		}),
 
 		KeyAuth: func(token string, scopes []string) (*models.Principal, error) {
 			return nil, errors.NotImplemented("oauth2 bearer auth (key) has not yet been implemented")
@@ -256,6 +416,9 @@ type ConsoleAPI struct {
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// MultipartformConsumer registers a consumer for the following mime types:
+	//   - multipart/form-data
+	MultipartformConsumer runtime.Consumer
 
 	// BinProducer registers a producer for the following mime types:
 	//   - application/octet-stream
@@ -271,12 +434,18 @@ type ConsoleAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// UserAPIAddBucketLifecycleRuleHandler sets the operation handler for the add bucket lifecycle rule operation
+	UserAPIAddBucketLifecycleRuleHandler user_api.AddBucketLifecycleRuleHandler
+	// UserAPIAddBucketReplicationRuleHandler sets the operation handler for the add bucket replication rule operation
+	UserAPIAddBucketReplicationRuleHandler user_api.AddBucketReplicationRuleHandler
 	// AdminAPIAddGroupHandler sets the operation handler for the add group operation
 	AdminAPIAddGroupHandler admin_api.AddGroupHandler
 	// AdminAPIAddNotificationEndpointHandler sets the operation handler for the add notification endpoint operation
 	AdminAPIAddNotificationEndpointHandler admin_api.AddNotificationEndpointHandler
 	// AdminAPIAddPolicyHandler sets the operation handler for the add policy operation
 	AdminAPIAddPolicyHandler admin_api.AddPolicyHandler
+	// AdminAPIAddRemoteBucketHandler sets the operation handler for the add remote bucket operation
+	AdminAPIAddRemoteBucketHandler admin_api.AddRemoteBucketHandler
 	// AdminAPIAddUserHandler sets the operation handler for the add user operation
 	AdminAPIAddUserHandler admin_api.AddUserHandler
 	// AdminAPIAdminInfoHandler sets the operation handler for the admin info operation
@@ -287,12 +456,18 @@ type ConsoleAPI struct {
 	UserAPIBucketInfoHandler user_api.BucketInfoHandler
 	// UserAPIBucketSetPolicyHandler sets the operation handler for the bucket set policy operation
 	UserAPIBucketSetPolicyHandler user_api.BucketSetPolicyHandler
+	// UserAPIBulkObjectsHandler sets the operation handler for the bulk objects operation
+	UserAPIBulkObjectsHandler user_api.BulkObjectsHandler
 	// AdminAPIBulkUpdateUsersGroupsHandler sets the operation handler for the bulk update users groups operation
 	AdminAPIBulkUpdateUsersGroupsHandler admin_api.BulkUpdateUsersGroupsHandler
 	// AdminAPIConfigInfoHandler sets the operation handler for the config info operation
 	AdminAPIConfigInfoHandler admin_api.ConfigInfoHandler
+	// UserAPICopyObjectsHandler sets the operation handler for the copy objects operation
+	UserAPICopyObjectsHandler user_api.CopyObjectsHandler
 	// UserAPICreateBucketEventHandler sets the operation handler for the create bucket event operation
 	UserAPICreateBucketEventHandler user_api.CreateBucketEventHandler
+	// UserAPICreateFolderHandler sets the operation handler for the create folder operation
+	UserAPICreateFolderHandler user_api.CreateFolderHandler
 	// UserAPICreateServiceAccountHandler sets the operation handler for the create service account operation
 	UserAPICreateServiceAccountHandler user_api.CreateServiceAccountHandler
 	// AdminAPICreateTenantHandler sets the operation handler for the create tenant operation
@@ -301,30 +476,82 @@ type ConsoleAPI struct {
 	UserAPIDeleteBucketHandler user_api.DeleteBucketHandler
 	// UserAPIDeleteBucketEventHandler sets the operation handler for the delete bucket event operation
 	UserAPIDeleteBucketEventHandler user_api.DeleteBucketEventHandler
+	// UserAPIDeleteBucketLifecycleRuleHandler sets the operation handler for the delete bucket lifecycle rule operation
+	UserAPIDeleteBucketLifecycleRuleHandler user_api.DeleteBucketLifecycleRuleHandler
+	// UserAPIDeleteBucketReplicationRuleHandler sets the operation handler for the delete bucket replication rule operation
+	UserAPIDeleteBucketReplicationRuleHandler user_api.DeleteBucketReplicationRuleHandler
+	// UserAPIDeleteBucketTagsHandler sets the operation handler for the delete bucket tags operation
+	UserAPIDeleteBucketTagsHandler user_api.DeleteBucketTagsHandler
+	// AdminAPIDeleteRemoteBucketHandler sets the operation handler for the delete remote bucket operation
+	AdminAPIDeleteRemoteBucketHandler admin_api.DeleteRemoteBucketHandler
 	// UserAPIDeleteServiceAccountHandler sets the operation handler for the delete service account operation
 	UserAPIDeleteServiceAccountHandler user_api.DeleteServiceAccountHandler
 	// AdminAPIDeleteTenantHandler sets the operation handler for the delete tenant operation
 	AdminAPIDeleteTenantHandler admin_api.DeleteTenantHandler
+	// UserAPIDisableBucketEncryptionHandler sets the operation handler for the disable bucket encryption operation
+	UserAPIDisableBucketEncryptionHandler user_api.DisableBucketEncryptionHandler
+	// AdminAPIDownloadImportedUsersSecretsHandler sets the operation handler for the download imported users secrets operation
+	AdminAPIDownloadImportedUsersSecretsHandler admin_api.DownloadImportedUsersSecretsHandler
+	// UserAPIDownloadObjectHandler sets the operation handler for the download object operation
+	UserAPIDownloadObjectHandler user_api.DownloadObjectHandler
+	// UserAPIDownloadObjectsZipHandler sets the operation handler for the download objects zip operation
+	UserAPIDownloadObjectsZipHandler user_api.DownloadObjectsZipHandler
+	// AdminAPIExportIamSnapshotHandler sets the operation handler for the export iam snapshot operation
+	AdminAPIExportIamSnapshotHandler admin_api.ExportIamSnapshotHandler
+	// AdminAPIExportUsersHandler sets the operation handler for the export users operation
+	AdminAPIExportUsersHandler admin_api.ExportUsersHandler
+	// UserAPIGetBucketLifecycleHandler sets the operation handler for the get bucket lifecycle operation
+	UserAPIGetBucketLifecycleHandler user_api.GetBucketLifecycleHandler
+	// UserAPIGetBucketPolicyDocumentHandler sets the operation handler for the get bucket policy document operation
+	UserAPIGetBucketPolicyDocumentHandler user_api.GetBucketPolicyDocumentHandler
+	// UserAPIGetBucketReplicationHandler sets the operation handler for the get bucket replication operation
+	UserAPIGetBucketReplicationHandler user_api.GetBucketReplicationHandler
+	// UserAPIGetBucketTagsHandler sets the operation handler for the get bucket tags operation
+	UserAPIGetBucketTagsHandler user_api.GetBucketTagsHandler
+	// UserAPIGetCopyOperationHandler sets the operation handler for the get copy operation operation
+	UserAPIGetCopyOperationHandler user_api.GetCopyOperationHandler
+	// UserAPIGetObjectMetadataHandler sets the operation handler for the get object metadata operation
+	UserAPIGetObjectMetadataHandler user_api.GetObjectMetadataHandler
+	// UserAPIGetObjectTagsHandler sets the operation handler for the get object tags operation
+	UserAPIGetObjectTagsHandler user_api.GetObjectTagsHandler
 	// AdminAPIGetResourceQuotaHandler sets the operation handler for the get resource quota operation
 	AdminAPIGetResourceQuotaHandler admin_api.GetResourceQuotaHandler
 	// AdminAPIGetTenantUsageHandler sets the operation handler for the get tenant usage operation
 	AdminAPIGetTenantUsageHandler admin_api.GetTenantUsageHandler
+	// AdminAPIGetUserEffectivePolicyHandler sets the operation handler for the get user effective policy operation
+	AdminAPIGetUserEffectivePolicyHandler admin_api.GetUserEffectivePolicyHandler
 	// AdminAPIGetUserInfoHandler sets the operation handler for the get user info operation
 	AdminAPIGetUserInfoHandler admin_api.GetUserInfoHandler
 	// AdminAPIGroupInfoHandler sets the operation handler for the group info operation
 	AdminAPIGroupInfoHandler admin_api.GroupInfoHandler
+	// AdminAPIImportIamSnapshotHandler sets the operation handler for the import iam snapshot operation
+	AdminAPIImportIamSnapshotHandler admin_api.ImportIamSnapshotHandler
+	// AdminAPIImportUsersHandler sets the operation handler for the import users operation
+	AdminAPIImportUsersHandler admin_api.ImportUsersHandler
 	// AdminAPIListAllTenantsHandler sets the operation handler for the list all tenants operation
 	AdminAPIListAllTenantsHandler admin_api.ListAllTenantsHandler
+	// UserAPIListBucketAnonymousAccessHandler sets the operation handler for the list bucket anonymous access operation
+	UserAPIListBucketAnonymousAccessHandler user_api.ListBucketAnonymousAccessHandler
 	// UserAPIListBucketEventsHandler sets the operation handler for the list bucket events operation
 	UserAPIListBucketEventsHandler user_api.ListBucketEventsHandler
+	// AdminAPIListBucketQuotasHandler sets the operation handler for the list bucket quotas operation
+	AdminAPIListBucketQuotasHandler admin_api.ListBucketQuotasHandler
 	// UserAPIListBucketsHandler sets the operation handler for the list buckets operation
 	UserAPIListBucketsHandler user_api.ListBucketsHandler
 	// AdminAPIListConfigHandler sets the operation handler for the list config operation
 	AdminAPIListConfigHandler admin_api.ListConfigHandler
 	// AdminAPIListGroupsHandler sets the operation handler for the list groups operation
 	AdminAPIListGroupsHandler admin_api.ListGroupsHandler
+	// UserAPIListObjectVersionsHandler sets the operation handler for the list object versions operation
+	UserAPIListObjectVersionsHandler user_api.ListObjectVersionsHandler
+	// UserAPIListObjectsHandler sets the operation handler for the list objects operation
+	UserAPIListObjectsHandler user_api.ListObjectsHandler
 	// AdminAPIListPoliciesHandler sets the operation handler for the list policies operation
 	AdminAPIListPoliciesHandler admin_api.ListPoliciesHandler
+	// AdminAPIListRemoteBucketsHandler sets the operation handler for the list remote buckets operation
+	AdminAPIListRemoteBucketsHandler admin_api.ListRemoteBucketsHandler
+	// AdminAPIListSharesHandler sets the operation handler for the list shares operation
+	AdminAPIListSharesHandler admin_api.ListSharesHandler
 	// AdminAPIListTenantsHandler sets the operation handler for the list tenants operation
 	AdminAPIListTenantsHandler admin_api.ListTenantsHandler
 	// UserAPIListUserServiceAccountsHandler sets the operation handler for the list user service accounts operation
@@ -347,10 +574,20 @@ type ConsoleAPI struct {
 	AdminAPINotificationEndpointListHandler admin_api.NotificationEndpointListHandler
 	// AdminAPIPolicyInfoHandler sets the operation handler for the policy info operation
 	AdminAPIPolicyInfoHandler admin_api.PolicyInfoHandler
+	// UserAPIPreviewObjectHandler sets the operation handler for the preview object operation
+	UserAPIPreviewObjectHandler user_api.PreviewObjectHandler
 	// AdminAPIProfilingStartHandler sets the operation handler for the profiling start operation
 	AdminAPIProfilingStartHandler admin_api.ProfilingStartHandler
 	// AdminAPIProfilingStopHandler sets the operation handler for the profiling stop operation
 	AdminAPIProfilingStopHandler admin_api.ProfilingStopHandler
+	// UserAPIPutBucketTagsHandler sets the operation handler for the put bucket tags operation
+	UserAPIPutBucketTagsHandler user_api.PutBucketTagsHandler
+	// UserAPIPutObjectLegalHoldHandler sets the operation handler for the put object legal hold operation
+	UserAPIPutObjectLegalHoldHandler user_api.PutObjectLegalHoldHandler
+	// UserAPIPutObjectRetentionHandler sets the operation handler for the put object retention operation
+	UserAPIPutObjectRetentionHandler user_api.PutObjectRetentionHandler
+	// UserAPIPutObjectTagsHandler sets the operation handler for the put object tags operation
+	UserAPIPutObjectTagsHandler user_api.PutObjectTagsHandler
 	// AdminAPIRemoveGroupHandler sets the operation handler for the remove group operation
 	AdminAPIRemoveGroupHandler admin_api.RemoveGroupHandler
 	// AdminAPIRemovePolicyHandler sets the operation handler for the remove policy operation
@@ -359,16 +596,46 @@ type ConsoleAPI struct {
 	AdminAPIRemoveUserHandler admin_api.RemoveUserHandler
 	// AdminAPIRestartServiceHandler sets the operation handler for the restart service operation
 	AdminAPIRestartServiceHandler admin_api.RestartServiceHandler
+	// UserAPIRestoreObjectVersionHandler sets the operation handler for the restore object version operation
+	UserAPIRestoreObjectVersionHandler user_api.RestoreObjectVersionHandler
+	// UserAPISelectObjectContentHandler sets the operation handler for the select object content operation
+	UserAPISelectObjectContentHandler user_api.SelectObjectContentHandler
 	// UserAPISessionCheckHandler sets the operation handler for the session check operation
 	UserAPISessionCheckHandler user_api.SessionCheckHandler
+	// UserAPISetBucketAnonymousAccessHandler sets the operation handler for the set bucket anonymous access operation
+	UserAPISetBucketAnonymousAccessHandler user_api.SetBucketAnonymousAccessHandler
+	// UserAPISetBucketEncryptionHandler sets the operation handler for the set bucket encryption operation
+	UserAPISetBucketEncryptionHandler user_api.SetBucketEncryptionHandler
+	// UserAPISetBucketLifecycleHandler sets the operation handler for the set bucket lifecycle operation
+	UserAPISetBucketLifecycleHandler user_api.SetBucketLifecycleHandler
+	// UserAPISetBucketPolicyDocumentHandler sets the operation handler for the set bucket policy document operation
+	UserAPISetBucketPolicyDocumentHandler user_api.SetBucketPolicyDocumentHandler
+	// UserAPISetBucketQuotaHandler sets the operation handler for the set bucket quota operation
+	UserAPISetBucketQuotaHandler user_api.SetBucketQuotaHandler
+	// UserAPISetBucketRetentionHandler sets the operation handler for the set bucket retention operation
+	UserAPISetBucketRetentionHandler user_api.SetBucketRetentionHandler
+	// UserAPISetBucketVersioningHandler sets the operation handler for the set bucket versioning operation
+	UserAPISetBucketVersioningHandler user_api.SetBucketVersioningHandler
 	// AdminAPISetConfigHandler sets the operation handler for the set config operation
 	AdminAPISetConfigHandler admin_api.SetConfigHandler
 	// AdminAPISetPolicyHandler sets the operation handler for the set policy operation
 	AdminAPISetPolicyHandler admin_api.SetPolicyHandler
+	// UserAPIShareObjectHandler sets the operation handler for the share object operation
+	UserAPIShareObjectHandler user_api.ShareObjectHandler
+	// AdminAPISimulatePolicyHandler sets the operation handler for the simulate policy operation
+	AdminAPISimulatePolicyHandler admin_api.SimulatePolicyHandler
 	// AdminAPITenantAddZoneHandler sets the operation handler for the tenant add zone operation
 	AdminAPITenantAddZoneHandler admin_api.TenantAddZoneHandler
 	// AdminAPITenantInfoHandler sets the operation handler for the tenant info operation
 	AdminAPITenantInfoHandler admin_api.TenantInfoHandler
+	// UserAPITestBucketEventHandler sets the operation handler for the test bucket event operation
+	UserAPITestBucketEventHandler user_api.TestBucketEventHandler
+	// UserAPIUpdateBucketEventHandler sets the operation handler for the update bucket event operation
+	UserAPIUpdateBucketEventHandler user_api.UpdateBucketEventHandler
+	// UserAPIUpdateBucketLifecycleRuleHandler sets the operation handler for the update bucket lifecycle rule operation
+	UserAPIUpdateBucketLifecycleRuleHandler user_api.UpdateBucketLifecycleRuleHandler
+	// UserAPIUpdateBucketReplicationRuleHandler sets the operation handler for the update bucket replication rule operation
+	UserAPIUpdateBucketReplicationRuleHandler user_api.UpdateBucketReplicationRuleHandler
 	// AdminAPIUpdateGroupHandler sets the operation handler for the update group operation
 	AdminAPIUpdateGroupHandler admin_api.UpdateGroupHandler
 	// AdminAPIUpdateTenantHandler sets the operation handler for the update tenant operation
@@ -377,6 +644,8 @@ type ConsoleAPI struct {
 	AdminAPIUpdateUserGroupsHandler admin_api.UpdateUserGroupsHandler
 	// AdminAPIUpdateUserInfoHandler sets the operation handler for the update user info operation
 	AdminAPIUpdateUserInfoHandler admin_api.UpdateUserInfoHandler
+	// UserAPIUploadObjectsHandler sets the operation handler for the upload objects operation
+	UserAPIUploadObjectsHandler user_api.UploadObjectsHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -438,6 +707,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.MultipartformConsumer == nil {
+		unregistered = append(unregistered, "MultipartformConsumer")
+	}
 
 	if o.BinProducer == nil {
 		unregistered = append(unregistered, "BinProducer")
@@ -450,6 +722,12 @@ func (o *ConsoleAPI) Validate() error {
 		unregistered = append(unregistered, "KeyAuth")
 	}
 
+	if o.UserAPIAddBucketLifecycleRuleHandler == nil {
+		unregistered = append(unregistered, "user_api.AddBucketLifecycleRuleHandler")
+	}
+	if o.UserAPIAddBucketReplicationRuleHandler == nil {
+		unregistered = append(unregistered, "user_api.AddBucketReplicationRuleHandler")
+	}
 	if o.AdminAPIAddGroupHandler == nil {
 		unregistered = append(unregistered, "admin_api.AddGroupHandler")
 	}
@@ -459,6 +737,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIAddPolicyHandler == nil {
 		unregistered = append(unregistered, "admin_api.AddPolicyHandler")
 	}
+	if o.AdminAPIAddRemoteBucketHandler == nil {
+		unregistered = append(unregistered, "admin_api.AddRemoteBucketHandler")
+	}
 	if o.AdminAPIAddUserHandler == nil {
 		unregistered = append(unregistered, "admin_api.AddUserHandler")
 	}
@@ -474,15 +755,24 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIBucketSetPolicyHandler == nil {
 		unregistered = append(unregistered, "user_api.BucketSetPolicyHandler")
 	}
+	if o.UserAPIBulkObjectsHandler == nil {
+		unregistered = append(unregistered, "user_api.BulkObjectsHandler")
+	}
 	if o.AdminAPIBulkUpdateUsersGroupsHandler == nil {
 		unregistered = append(unregistered, "admin_api.BulkUpdateUsersGroupsHandler")
 	}
 	if o.AdminAPIConfigInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.ConfigInfoHandler")
 	}
+	if o.UserAPICopyObjectsHandler == nil {
+		unregistered = append(unregistered, "user_api.CopyObjectsHandler")
+	}
 	if o.UserAPICreateBucketEventHandler == nil {
 		unregistered = append(unregistered, "user_api.CreateBucketEventHandler")
 	}
+	if o.UserAPICreateFolderHandler == nil {
+		unregistered = append(unregistered, "user_api.CreateFolderHandler")
+	}
 	if o.UserAPICreateServiceAccountHandler == nil {
 		unregistered = append(unregistered, "user_api.CreateServiceAccountHandler")
 	}
@@ -495,30 +785,96 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIDeleteBucketEventHandler == nil {
 		unregistered = append(unregistered, "user_api.DeleteBucketEventHandler")
 	}
+	if o.UserAPIDeleteBucketLifecycleRuleHandler == nil {
+		unregistered = append(unregistered, "user_api.DeleteBucketLifecycleRuleHandler")
+	}
+	if o.UserAPIDeleteBucketReplicationRuleHandler == nil {
+		unregistered = append(unregistered, "user_api.DeleteBucketReplicationRuleHandler")
+	}
+	if o.UserAPIDeleteBucketTagsHandler == nil {
+		unregistered = append(unregistered, "user_api.DeleteBucketTagsHandler")
+	}
+	if o.AdminAPIDeleteRemoteBucketHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteRemoteBucketHandler")
+	}
 	if o.UserAPIDeleteServiceAccountHandler == nil {
 		unregistered = append(unregistered, "user_api.DeleteServiceAccountHandler")
 	}
 	if o.AdminAPIDeleteTenantHandler == nil {
 		unregistered = append(unregistered, "admin_api.DeleteTenantHandler")
 	}
+	if o.UserAPIDisableBucketEncryptionHandler == nil {
+		unregistered = append(unregistered, "user_api.DisableBucketEncryptionHandler")
+	}
+	if o.AdminAPIDownloadImportedUsersSecretsHandler == nil {
+		unregistered = append(unregistered, "admin_api.DownloadImportedUsersSecretsHandler")
+	}
+	if o.UserAPIDownloadObjectHandler == nil {
+		unregistered = append(unregistered, "user_api.DownloadObjectHandler")
+	}
+	if o.UserAPIDownloadObjectsZipHandler == nil {
+		unregistered = append(unregistered, "user_api.DownloadObjectsZipHandler")
+	}
+	if o.AdminAPIExportIamSnapshotHandler == nil {
+		unregistered = append(unregistered, "admin_api.ExportIamSnapshotHandler")
+	}
+	if o.AdminAPIExportUsersHandler == nil {
+		unregistered = append(unregistered, "admin_api.ExportUsersHandler")
+	}
+	if o.UserAPIGetBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "user_api.GetBucketLifecycleHandler")
+	}
+	if o.UserAPIGetBucketPolicyDocumentHandler == nil {
+		unregistered = append(unregistered, "user_api.GetBucketPolicyDocumentHandler")
+	}
+	if o.UserAPIGetBucketReplicationHandler == nil {
+		unregistered = append(unregistered, "user_api.GetBucketReplicationHandler")
+	}
+	if o.UserAPIGetBucketTagsHandler == nil {
+		unregistered = append(unregistered, "user_api.GetBucketTagsHandler")
+	}
+	if o.UserAPIGetCopyOperationHandler == nil {
+		unregistered = append(unregistered, "user_api.GetCopyOperationHandler")
+	}
+	if o.UserAPIGetObjectMetadataHandler == nil {
+		unregistered = append(unregistered, "user_api.GetObjectMetadataHandler")
+	}
+	if o.UserAPIGetObjectTagsHandler == nil {
+		unregistered = append(unregistered, "user_api.GetObjectTagsHandler")
+	}
 	if o.AdminAPIGetResourceQuotaHandler == nil {
 		unregistered = append(unregistered, "admin_api.GetResourceQuotaHandler")
 	}
 	if o.AdminAPIGetTenantUsageHandler == nil {
 		unregistered = append(unregistered, "admin_api.GetTenantUsageHandler")
 	}
+	if o.AdminAPIGetUserEffectivePolicyHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetUserEffectivePolicyHandler")
+	}
 	if o.AdminAPIGetUserInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.GetUserInfoHandler")
 	}
 	if o.AdminAPIGroupInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.GroupInfoHandler")
 	}
+	if o.AdminAPIImportIamSnapshotHandler == nil {
+		unregistered = append(unregistered, "admin_api.ImportIamSnapshotHandler")
+	}
+	if o.AdminAPIImportUsersHandler == nil {
+		unregistered = append(unregistered, "admin_api.ImportUsersHandler")
+	}
 	if o.AdminAPIListAllTenantsHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListAllTenantsHandler")
 	}
+	if o.UserAPIListBucketAnonymousAccessHandler == nil {
+		unregistered = append(unregistered, "user_api.ListBucketAnonymousAccessHandler")
+	}
 	if o.UserAPIListBucketEventsHandler == nil {
 		unregistered = append(unregistered, "user_api.ListBucketEventsHandler")
 	}
+	if o.AdminAPIListBucketQuotasHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListBucketQuotasHandler")
+	}
 	if o.UserAPIListBucketsHandler == nil {
 		unregistered = append(unregistered, "user_api.ListBucketsHandler")
 	}
@@ -528,9 +884,21 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIListGroupsHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListGroupsHandler")
 	}
+	if o.UserAPIListObjectVersionsHandler == nil {
+		unregistered = append(unregistered, "user_api.ListObjectVersionsHandler")
+	}
+	if o.UserAPIListObjectsHandler == nil {
+		unregistered = append(unregistered, "user_api.ListObjectsHandler")
+	}
 	if o.AdminAPIListPoliciesHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListPoliciesHandler")
 	}
+	if o.AdminAPIListRemoteBucketsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListRemoteBucketsHandler")
+	}
+	if o.AdminAPIListSharesHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListSharesHandler")
+	}
 	if o.AdminAPIListTenantsHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListTenantsHandler")
 	}
@@ -564,12 +932,27 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIPolicyInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.PolicyInfoHandler")
 	}
+	if o.UserAPIPreviewObjectHandler == nil {
+		unregistered = append(unregistered, "user_api.PreviewObjectHandler")
+	}
 	if o.AdminAPIProfilingStartHandler == nil {
 		unregistered = append(unregistered, "admin_api.ProfilingStartHandler")
 	}
 	if o.AdminAPIProfilingStopHandler == nil {
 		unregistered = append(unregistered, "admin_api.ProfilingStopHandler")
 	}
+	if o.UserAPIPutBucketTagsHandler == nil {
+		unregistered = append(unregistered, "user_api.PutBucketTagsHandler")
+	}
+	if o.UserAPIPutObjectLegalHoldHandler == nil {
+		unregistered = append(unregistered, "user_api.PutObjectLegalHoldHandler")
+	}
+	if o.UserAPIPutObjectRetentionHandler == nil {
+		unregistered = append(unregistered, "user_api.PutObjectRetentionHandler")
+	}
+	if o.UserAPIPutObjectTagsHandler == nil {
+		unregistered = append(unregistered, "user_api.PutObjectTagsHandler")
+	}
 	if o.AdminAPIRemoveGroupHandler == nil {
 		unregistered = append(unregistered, "admin_api.RemoveGroupHandler")
 	}
@@ -582,21 +965,66 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIRestartServiceHandler == nil {
 		unregistered = append(unregistered, "admin_api.RestartServiceHandler")
 	}
+	if o.UserAPIRestoreObjectVersionHandler == nil {
+		unregistered = append(unregistered, "user_api.RestoreObjectVersionHandler")
+	}
+	if o.UserAPISelectObjectContentHandler == nil {
+		unregistered = append(unregistered, "user_api.SelectObjectContentHandler")
+	}
 	if o.UserAPISessionCheckHandler == nil {
 		unregistered = append(unregistered, "user_api.SessionCheckHandler")
 	}
+	if o.UserAPISetBucketAnonymousAccessHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketAnonymousAccessHandler")
+	}
+	if o.UserAPISetBucketEncryptionHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketEncryptionHandler")
+	}
+	if o.UserAPISetBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketLifecycleHandler")
+	}
+	if o.UserAPISetBucketPolicyDocumentHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketPolicyDocumentHandler")
+	}
+	if o.UserAPISetBucketQuotaHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketQuotaHandler")
+	}
+	if o.UserAPISetBucketRetentionHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketRetentionHandler")
+	}
+	if o.UserAPISetBucketVersioningHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketVersioningHandler")
+	}
 	if o.AdminAPISetConfigHandler == nil {
 		unregistered = append(unregistered, "admin_api.SetConfigHandler")
 	}
 	if o.AdminAPISetPolicyHandler == nil {
 		unregistered = append(unregistered, "admin_api.SetPolicyHandler")
 	}
+	if o.UserAPIShareObjectHandler == nil {
+		unregistered = append(unregistered, "user_api.ShareObjectHandler")
+	}
+	if o.AdminAPISimulatePolicyHandler == nil {
+		unregistered = append(unregistered, "admin_api.SimulatePolicyHandler")
+	}
 	if o.AdminAPITenantAddZoneHandler == nil {
 		unregistered = append(unregistered, "admin_api.TenantAddZoneHandler")
 	}
 	if o.AdminAPITenantInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.TenantInfoHandler")
 	}
+	if o.UserAPITestBucketEventHandler == nil {
+		unregistered = append(unregistered, "user_api.TestBucketEventHandler")
+	}
+	if o.UserAPIUpdateBucketEventHandler == nil {
+		unregistered = append(unregistered, "user_api.UpdateBucketEventHandler")
+	}
+	if o.UserAPIUpdateBucketLifecycleRuleHandler == nil {
+		unregistered = append(unregistered, "user_api.UpdateBucketLifecycleRuleHandler")
+	}
+	if o.UserAPIUpdateBucketReplicationRuleHandler == nil {
+		unregistered = append(unregistered, "user_api.UpdateBucketReplicationRuleHandler")
+	}
 	if o.AdminAPIUpdateGroupHandler == nil {
 		unregistered = append(unregistered, "admin_api.UpdateGroupHandler")
 	}
@@ -609,6 +1037,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIUpdateUserInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.UpdateUserInfoHandler")
 	}
+	if o.UserAPIUploadObjectsHandler == nil {
+		unregistered = append(unregistered, "user_api.UploadObjectsHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -650,6 +1081,8 @@ func (o *ConsoleAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consum
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "multipart/form-data":
+			result["multipart/form-data"] = o.MultipartformConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -709,6 +1142,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/lifecycle"] = user_api.NewAddBucketLifecycleRule(o.context, o.UserAPIAddBucketLifecycleRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/replication"] = user_api.NewAddBucketReplicationRule(o.context, o.UserAPIAddBucketReplicationRuleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -724,6 +1165,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/remote-buckets"] = admin_api.NewAddRemoteBucket(o.context, o.AdminAPIAddRemoteBucketHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/users"] = admin_api.NewAddUser(o.context, o.AdminAPIAddUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -741,6 +1186,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/buckets/{name}/set-policy"] = user_api.NewBucketSetPolicy(o.context, o.UserAPIBucketSetPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/bulk"] = user_api.NewBulkObjects(o.context, o.UserAPIBulkObjectsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -752,10 +1201,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/copy"] = user_api.NewCopyObjects(o.context, o.UserAPICopyObjectsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/{bucket_name}/events"] = user_api.NewCreateBucketEvent(o.context, o.UserAPICreateBucketEventHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/folder"] = user_api.NewCreateFolder(o.context, o.UserAPICreateFolderHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/service-accounts"] = user_api.NewCreateServiceAccount(o.context, o.UserAPICreateServiceAccountHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -772,11 +1229,79 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/lifecycle/{rule_id}"] = user_api.NewDeleteBucketLifecycleRule(o.context, o.UserAPIDeleteBucketLifecycleRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/replication/{rule_id}"] = user_api.NewDeleteBucketReplicationRule(o.context, o.UserAPIDeleteBucketReplicationRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/tags"] = user_api.NewDeleteBucketTags(o.context, o.UserAPIDeleteBucketTagsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/remote-buckets/{bucket_name}/{arn}"] = admin_api.NewDeleteRemoteBucket(o.context, o.AdminAPIDeleteRemoteBucketHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/service-accounts/{access_key}"] = user_api.NewDeleteServiceAccount(o.context, o.UserAPIDeleteServiceAccountHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}"] = admin_api.NewDeleteTenant(o.context, o.AdminAPIDeleteTenantHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/encryption"] = user_api.NewDisableBucketEncryption(o.context, o.UserAPIDisableBucketEncryptionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users-import/secrets/{token}"] = admin_api.NewDownloadImportedUsersSecrets(o.context, o.AdminAPIDownloadImportedUsersSecretsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/download"] = user_api.NewDownloadObject(o.context, o.UserAPIDownloadObjectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/download-zip"] = user_api.NewDownloadObjectsZip(o.context, o.UserAPIDownloadObjectsZipHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/iam/snapshot"] = admin_api.NewExportIamSnapshot(o.context, o.AdminAPIExportIamSnapshotHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users-export"] = admin_api.NewExportUsers(o.context, o.AdminAPIExportUsersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/lifecycle"] = user_api.NewGetBucketLifecycle(o.context, o.UserAPIGetBucketLifecycleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/policy"] = user_api.NewGetBucketPolicyDocument(o.context, o.UserAPIGetBucketPolicyDocumentHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication"] = user_api.NewGetBucketReplication(o.context, o.UserAPIGetBucketReplicationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/tags"] = user_api.NewGetBucketTags(o.context, o.UserAPIGetBucketTagsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/copy/{operation_id}"] = user_api.NewGetCopyOperation(o.context, o.UserAPIGetCopyOperationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/metadata"] = user_api.NewGetObjectMetadata(o.context, o.UserAPIGetObjectMetadataHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/tags"] = user_api.NewGetObjectTags(o.context, o.UserAPIGetObjectTagsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -788,11 +1313,23 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/users/{name}/effective-policy"] = admin_api.NewGetUserEffectivePolicy(o.context, o.AdminAPIGetUserEffectivePolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/users/{name}"] = admin_api.NewGetUserInfo(o.context, o.AdminAPIGetUserInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/groups/{name}"] = admin_api.NewGroupInfo(o.context, o.AdminAPIGroupInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/iam/snapshot/import"] = admin_api.NewImportIamSnapshot(o.context, o.AdminAPIImportIamSnapshotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users-import"] = admin_api.NewImportUsers(o.context, o.AdminAPIImportUsersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -800,10 +1337,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/policy/anonymous"] = user_api.NewListBucketAnonymousAccess(o.context, o.UserAPIListBucketAnonymousAccessHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/events"] = user_api.NewListBucketEvents(o.context, o.UserAPIListBucketEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/bucket-quotas"] = admin_api.NewListBucketQuotas(o.context, o.AdminAPIListBucketQuotasHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets"] = user_api.NewListBuckets(o.context, o.UserAPIListBucketsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -816,10 +1361,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/versions"] = user_api.NewListObjectVersions(o.context, o.UserAPIListObjectVersionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects"] = user_api.NewListObjects(o.context, o.UserAPIListObjectsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/policies"] = admin_api.NewListPolicies(o.context, o.AdminAPIListPoliciesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/remote-buckets"] = admin_api.NewListRemoteBuckets(o.context, o.AdminAPIListRemoteBucketsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/shares"] = admin_api.NewListShares(o.context, o.AdminAPIListSharesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/namespaces/{namespace}/tenants"] = admin_api.NewListTenants(o.context, o.AdminAPIListTenantsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -861,6 +1422,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/policies/{name}"] = admin_api.NewPolicyInfo(o.context, o.AdminAPIPolicyInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/preview"] = user_api.NewPreviewObject(o.context, o.UserAPIPreviewObjectHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -869,6 +1434,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/profiling/stop"] = admin_api.NewProfilingStop(o.context, o.AdminAPIProfilingStopHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/tags"] = user_api.NewPutBucketTags(o.context, o.UserAPIPutBucketTagsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/objects/legal-hold"] = user_api.NewPutObjectLegalHold(o.context, o.UserAPIPutObjectLegalHoldHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/objects/retention"] = user_api.NewPutObjectRetention(o.context, o.UserAPIPutObjectRetentionHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/objects/tags"] = user_api.NewPutObjectTags(o.context, o.UserAPIPutObjectTagsHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -885,6 +1466,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/service/restart"] = admin_api.NewRestartService(o.context, o.AdminAPIRestartServiceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/restore"] = user_api.NewRestoreObjectVersion(o.context, o.UserAPIRestoreObjectVersionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/select"] = user_api.NewSelectObjectContent(o.context, o.UserAPISelectObjectContentHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -892,6 +1481,34 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/policy/anonymous"] = user_api.NewSetBucketAnonymousAccess(o.context, o.UserAPISetBucketAnonymousAccessHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/encryption"] = user_api.NewSetBucketEncryption(o.context, o.UserAPISetBucketEncryptionHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/lifecycle"] = user_api.NewSetBucketLifecycle(o.context, o.UserAPISetBucketLifecycleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/policy"] = user_api.NewSetBucketPolicyDocument(o.context, o.UserAPISetBucketPolicyDocumentHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{name}/quota"] = user_api.NewSetBucketQuota(o.context, o.UserAPISetBucketQuotaHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/retention"] = user_api.NewSetBucketRetention(o.context, o.UserAPISetBucketRetentionHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/versioning"] = user_api.NewSetBucketVersioning(o.context, o.UserAPISetBucketVersioningHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/configs/{name}"] = admin_api.NewSetConfig(o.context, o.AdminAPISetConfigHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -900,11 +1517,35 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/share"] = user_api.NewShareObject(o.context, o.UserAPIShareObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/simulate-policy"] = admin_api.NewSimulatePolicy(o.context, o.AdminAPISimulatePolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/zones"] = admin_api.NewTenantAddZone(o.context, o.AdminAPITenantAddZoneHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}"] = admin_api.NewTenantInfo(o.context, o.AdminAPITenantInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/events/{arn}/{id}/test"] = user_api.NewTestBucketEvent(o.context, o.UserAPITestBucketEventHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/events/{arn}/{id}"] = user_api.NewUpdateBucketEvent(o.context, o.UserAPIUpdateBucketEventHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/lifecycle/{rule_id}"] = user_api.NewUpdateBucketLifecycleRule(o.context, o.UserAPIUpdateBucketLifecycleRuleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/replication/{rule_id}"] = user_api.NewUpdateBucketReplicationRule(o.context, o.UserAPIUpdateBucketReplicationRuleHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -921,6 +1562,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/users/{name}"] = admin_api.NewUpdateUserInfo(o.context, o.AdminAPIUpdateUserInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/upload"] = user_api.NewUploadObjects(o.context, o.UserAPIUploadObjectsHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -27,12 +27,16 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // DeleteBucketURL generates an URL for the delete bucket operation
 type DeleteBucketURL struct {
 	Name string
 
+	Force *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -72,6 +76,18 @@ func (o *DeleteBucketURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var forceQ string
+	if o.Force != nil {
+		forceQ = swag.FormatBool(*o.Force)
+	}
+	if forceQ != "" {
+		qs.Set("force", forceQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -48,18 +48,42 @@ type ListBucketsParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*
+	/*returns the buckets along with their tags, always done when tag_key is set
+	  In: query
+	*/
+	IncludeTags *bool
+	/*maximum number of buckets to return, every matching bucket if not set
 	  In: query
 	*/
 	Limit *int32
-	/*
+	/*number of matching buckets to skip
 	  In: query
 	*/
 	Offset *int32
-	/*
+	/*only returns buckets whose name starts with this prefix
+	  In: query
+	*/
+	Prefix *string
+	/*only returns buckets whose name contains this text, case insensitive
+	  In: query
+	*/
+	Search *string
+	/*name, size or creation_date, buckets are sorted by name if not set
 	  In: query
 	*/
 	SortBy *string
+	/*asc or desc, asc if not set
+	  In: query
+	*/
+	SortOrder *string
+	/*only returns buckets having this tag
+	  In: query
+	*/
+	TagKey *string
+	/*value the tag set on tag_key must have, any value if not set
+	  In: query
+	*/
+	TagValue *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -73,6 +97,11 @@ func (o *ListBucketsParams) BindRequest(r *http.Request, route *middleware.Match
 
 	qs := runtime.Values(r.URL.Query())
 
+	qIncludeTags, qhkIncludeTags, _ := qs.GetOK("include_tags")
+	if err := o.bindIncludeTags(qIncludeTags, qhkIncludeTags, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
@@ -83,17 +112,64 @@ func (o *ListBucketsParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qPrefix, qhkPrefix, _ := qs.GetOK("prefix")
+	if err := o.bindPrefix(qPrefix, qhkPrefix, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSearch, qhkSearch, _ := qs.GetOK("search")
+	if err := o.bindSearch(qSearch, qhkSearch, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qSortBy, qhkSortBy, _ := qs.GetOK("sort_by")
 	if err := o.bindSortBy(qSortBy, qhkSortBy, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qSortOrder, qhkSortOrder, _ := qs.GetOK("sort_order")
+	if err := o.bindSortOrder(qSortOrder, qhkSortOrder, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTagKey, qhkTagKey, _ := qs.GetOK("tag_key")
+	if err := o.bindTagKey(qTagKey, qhkTagKey, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTagValue, qhkTagValue, _ := qs.GetOK("tag_value")
+	if err := o.bindTagValue(qTagValue, qhkTagValue, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindIncludeTags binds and validates parameter IncludeTags from query.
+func (o *ListBucketsParams) bindIncludeTags(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("include_tags", "query", "bool", raw)
+	}
+	o.IncludeTags = &value
+
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *ListBucketsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -138,6 +214,42 @@ func (o *ListBucketsParams) bindOffset(rawData []string, hasKey bool, formats st
 	return nil
 }
 
+// bindPrefix binds and validates parameter Prefix from query.
+func (o *ListBucketsParams) bindPrefix(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Prefix = &raw
+
+	return nil
+}
+
+// bindSearch binds and validates parameter Search from query.
+func (o *ListBucketsParams) bindSearch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Search = &raw
+
+	return nil
+}
+
 // bindSortBy binds and validates parameter SortBy from query.
 func (o *ListBucketsParams) bindSortBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -155,3 +267,57 @@ func (o *ListBucketsParams) bindSortBy(rawData []string, hasKey bool, formats st
 
 	return nil
 }
+
+// bindSortOrder binds and validates parameter SortOrder from query.
+func (o *ListBucketsParams) bindSortOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.SortOrder = &raw
+
+	return nil
+}
+
+// bindTagKey binds and validates parameter TagKey from query.
+func (o *ListBucketsParams) bindTagKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.TagKey = &raw
+
+	return nil
+}
+
+// bindTagValue binds and validates parameter TagValue from query.
+func (o *ListBucketsParams) bindTagValue(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.TagValue = &raw
+
+	return nil
+}
@@ -32,9 +32,15 @@ import (
 
 // ListBucketsURL generates an URL for the list buckets operation
 type ListBucketsURL struct {
-	Limit  *int32
-	Offset *int32
-	SortBy *string
+	IncludeTags *bool
+	Limit       *int32
+	Offset      *int32
+	Prefix      *string
+	Search      *string
+	SortBy      *string
+	SortOrder   *string
+	TagKey      *string
+	TagValue    *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -70,6 +76,14 @@ func (o *ListBucketsURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var includeTagsQ string
+	if o.IncludeTags != nil {
+		includeTagsQ = swag.FormatBool(*o.IncludeTags)
+	}
+	if includeTagsQ != "" {
+		qs.Set("include_tags", includeTagsQ)
+	}
+
 	var limitQ string
 	if o.Limit != nil {
 		limitQ = swag.FormatInt32(*o.Limit)
@@ -86,6 +100,22 @@ func (o *ListBucketsURL) Build() (*url.URL, error) {
 		qs.Set("offset", offsetQ)
 	}
 
+	var prefixQ string
+	if o.Prefix != nil {
+		prefixQ = *o.Prefix
+	}
+	if prefixQ != "" {
+		qs.Set("prefix", prefixQ)
+	}
+
+	var searchQ string
+	if o.Search != nil {
+		searchQ = *o.Search
+	}
+	if searchQ != "" {
+		qs.Set("search", searchQ)
+	}
+
 	var sortByQ string
 	if o.SortBy != nil {
 		sortByQ = *o.SortBy
@@ -94,6 +124,30 @@ func (o *ListBucketsURL) Build() (*url.URL, error) {
 		qs.Set("sort_by", sortByQ)
 	}
 
+	var sortOrderQ string
+	if o.SortOrder != nil {
+		sortOrderQ = *o.SortOrder
+	}
+	if sortOrderQ != "" {
+		qs.Set("sort_order", sortOrderQ)
+	}
+
+	var tagKeyQ string
+	if o.TagKey != nil {
+		tagKeyQ = *o.TagKey
+	}
+	if tagKeyQ != "" {
+		qs.Set("tag_key", tagKeyQ)
+	}
+
+	var tagValueQ string
+	if o.TagValue != nil {
+		tagValueQ = *o.TagValue
+	}
+	if tagValueQ != "" {
+		qs.Set("tag_value", tagValueQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
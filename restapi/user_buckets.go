@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -30,14 +31,16 @@ import (
 	"github.com/minio/console/models"
 	"github.com/minio/console/restapi/operations"
 	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/policy"
 	minioIAMPolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
 )
 
 func registerBucketsHandlers(api *operations.ConsoleAPI) {
 	// list buckets
 	api.UserAPIListBucketsHandler = user_api.ListBucketsHandlerFunc(func(params user_api.ListBucketsParams, session *models.Principal) middleware.Responder {
-		listBucketsResponse, err := getListBucketsResponse(session)
+		listBucketsResponse, err := getListBucketsResponse(session, params)
 		if err != nil {
 			return user_api.NewListBucketsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
 		}
@@ -75,6 +78,13 @@ func registerBucketsHandlers(api *operations.ConsoleAPI) {
 		}
 		return user_api.NewBucketSetPolicyOK().WithPayload(bucketSetPolicyResp)
 	})
+	// enable or suspend bucket versioning
+	api.UserAPISetBucketVersioningHandler = user_api.SetBucketVersioningHandlerFunc(func(params user_api.SetBucketVersioningParams, session *models.Principal) middleware.Responder {
+		if err := getSetBucketVersioningResponse(session, params); err != nil {
+			return user_api.NewSetBucketVersioningDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewSetBucketVersioningNoContent()
+	})
 }
 
 // getaAcountUsageInfo fetches a list of all buckets allowed to that particular client from MinIO Servers
@@ -83,16 +93,105 @@ func getaAcountUsageInfo(ctx context.Context, client MinioAdmin) ([]*models.Buck
 	if err != nil {
 		return []*models.Bucket{}, err
 	}
+	return bucketsUsageInfoToModel(info.Buckets), nil
+}
+
+// bucketsUsageInfoToModel serializes the buckets of the account usage info
+func bucketsUsageInfoToModel(buckets []madmin.BucketUsageInfo) []*models.Bucket {
 	var bucketInfos []*models.Bucket
-	for _, bucket := range info.Buckets {
+	for _, bucket := range buckets {
 		bucketElem := &models.Bucket{Name: swag.String(bucket.Name), CreationDate: bucket.Created.String(), Size: int64(bucket.Size)}
 		bucketInfos = append(bucketInfos, bucketElem)
 	}
-	return bucketInfos, nil
+	return bucketInfos
+}
+
+const (
+	bucketsSortByName         = "name"
+	bucketsSortBySize         = "size"
+	bucketsSortByCreationDate = "creation_date"
+)
+
+// searchBuckets returns the buckets whose name contains search, ignoring case, and starts with prefix
+func searchBuckets(buckets []*models.Bucket, search, prefix string) []*models.Bucket {
+	if search == "" && prefix == "" {
+		return buckets
+	}
+	search = strings.ToLower(search)
+	var result []*models.Bucket
+	for _, bucket := range buckets {
+		name := swag.StringValue(bucket.Name)
+		if strings.HasPrefix(name, prefix) && strings.Contains(strings.ToLower(name), search) {
+			result = append(result, bucket)
+		}
+	}
+	return result
+}
+
+// sortBuckets sorts the buckets by name, size or creation date, ties are sorted by name. Buckets are
+// sorted before serializing them so the creation date is compared as a time.
+func sortBuckets(buckets []madmin.BucketUsageInfo, sortBy, sortOrder string) error {
+	var less func(a, b madmin.BucketUsageInfo) bool
+	switch sortBy {
+	case "", bucketsSortByName:
+		less = func(a, b madmin.BucketUsageInfo) bool { return false }
+	case bucketsSortBySize:
+		less = func(a, b madmin.BucketUsageInfo) bool { return a.Size < b.Size }
+	case bucketsSortByCreationDate:
+		less = func(a, b madmin.BucketUsageInfo) bool { return a.Created.Before(b.Created) }
+	default:
+		return errors.New(500, "error invalid sort_by %s, it must be name, size or creation_date", sortBy)
+	}
+	var desc bool
+	switch sortOrder {
+	case "", "asc":
+	case "desc":
+		desc = true
+	default:
+		return errors.New(500, "error invalid sort_order %s, it must be asc or desc", sortOrder)
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		a, b := buckets[i], buckets[j]
+		if desc {
+			a, b = b, a
+		}
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		return a.Name < b.Name
+	})
+	return nil
 }
 
-// getListBucketsResponse performs listBuckets() and serializes it to the handler's output
-func getListBucketsResponse(session *models.Principal) (*models.ListBucketsResponse, error) {
+// paginateBuckets returns the page of buckets starting at offset, every remaining bucket if limit is nil
+func paginateBuckets(buckets []*models.Bucket, offset, limit *int32) ([]*models.Bucket, error) {
+	start := int(swag.Int32Value(offset))
+	if start < 0 {
+		return nil, errors.New(500, "error offset can't be negative")
+	}
+	if start > len(buckets) {
+		start = len(buckets)
+	}
+	end := len(buckets)
+	if limit != nil {
+		if *limit < 0 {
+			return nil, errors.New(500, "error limit can't be negative")
+		}
+		if start+int(*limit) < end {
+			end = start + int(*limit)
+		}
+	}
+	return buckets[start:end], nil
+}
+
+// getListBucketsResponse performs listBuckets() and serializes it to the handler's output,
+// buckets are sorted, searched and paginated, the total is the number of buckets matching before
+// pagination. Tags are only fetched when filtering by one of them, or for the returned page when
+// include_tags is set.
+func getListBucketsResponse(session *models.Principal, params user_api.ListBucketsParams) (*models.ListBucketsResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
 	defer cancel()
 
@@ -104,30 +203,57 @@ func getListBucketsResponse(session *models.Principal) (*models.ListBucketsRespo
 	// create a minioClient interface implementation
 	// defining the client to be used
 	adminClient := adminClient{client: mAdmin}
-	buckets, err := getaAcountUsageInfo(ctx, adminClient)
+	info, err := adminClient.accountUsageInfo(ctx)
 	if err != nil {
 		log.Println("error accountingUsageInfo:", err)
 		return nil, err
 	}
+	if err := sortBuckets(info.Buckets, swag.StringValue(params.SortBy), swag.StringValue(params.SortOrder)); err != nil {
+		log.Println("error sorting buckets:", err)
+		return nil, err
+	}
+	buckets := bucketsUsageInfoToModel(info.Buckets)
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	buckets = searchBuckets(buckets, swag.StringValue(params.Search), swag.StringValue(params.Prefix))
+	if params.TagKey != nil {
+		addBucketsTags(ctx, minioClient, buckets)
+		buckets = filterBucketsByTag(buckets, *params.TagKey, params.TagValue)
+	}
+	total := len(buckets)
+	buckets, err = paginateBuckets(buckets, params.Offset, params.Limit)
+	if err != nil {
+		log.Println("error paginating buckets:", err)
+		return nil, err
+	}
+	if params.TagKey == nil && swag.BoolValue(params.IncludeTags) {
+		addBucketsTags(ctx, minioClient, buckets)
+	}
 
 	// serialize output
 	listBucketsResponse := &models.ListBucketsResponse{
 		Buckets: buckets,
-		Total:   int64(len(buckets)),
+		Total:   int64(total),
 	}
 	return listBucketsResponse, nil
 }
 
 // makeBucket creates a bucket for an specific minio client
-func makeBucket(ctx context.Context, client MinioClient, bucketName string) error {
+func makeBucket(ctx context.Context, client MinioClient, bucketName string, objectLocking bool) error {
 	// creates a new bucket with bucketName with a context to control cancellations and timeouts.
-	if err := client.makeBucketWithContext(ctx, bucketName, "us-east-1"); err != nil {
+	if err := client.makeBucketWithContext(ctx, bucketName, "us-east-1", objectLocking); err != nil {
 		return err
 	}
 	return nil
 }
 
-// getMakeBucketResponse performs makeBucket() to create a bucket with its access policy
+// getMakeBucketResponse performs createBucket() to create a bucket with its settings
 func getMakeBucketResponse(session *models.Principal, br *models.MakeBucketRequest) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
 	defer cancel()
@@ -145,13 +271,65 @@ func getMakeBucketResponse(session *models.Principal, br *models.MakeBucketReque
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
 
-	if err := makeBucket(ctx, minioClient, *br.Name); err != nil {
+	// the default retention only applies to buckets with object locking
+	if br.Retention != nil && !br.ObjectLocking {
+		log.Println("error retention requires object locking")
+		return errors.New(500, "error object locking must be enabled to set a default retention")
+	}
+	// quotas are managed through the admin API, its client is created before the bucket
+	// so a failure doesn't leave the bucket behind
+	var adminClientQuota MinioAdmin
+	if br.Quota != nil && br.Quota.Quota > 0 {
+		mAdmin, err := newMAdminClient(session)
+		if err != nil {
+			log.Println("error creating Madmin Client:", err)
+			return err
+		}
+		adminClientQuota = adminClient{client: mAdmin}
+	}
+	if err := createBucket(ctx, minioClient, adminClientQuota, br); err != nil {
 		log.Println("error making bucket:", err)
 		return err
 	}
 	return nil
 }
 
+// createBucket makes the bucket and applies its encryption, retention and quota. If any of the settings
+// fails the bucket, still empty, is removed so the request can be retried. adminClient is only used
+// when a quota is requested.
+func createBucket(ctx context.Context, client MinioClient, adminClient MinioAdmin, br *models.MakeBucketRequest) error {
+	if err := makeBucket(ctx, client, *br.Name, br.ObjectLocking); err != nil {
+		return err
+	}
+	if err := setInitialBucketSettings(ctx, client, adminClient, br); err != nil {
+		if rErr := client.removeBucket(ctx, *br.Name); rErr != nil {
+			log.Println("error removing bucket after its settings failed:", rErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// setInitialBucketSettings applies the settings requested along with a new bucket
+func setInitialBucketSettings(ctx context.Context, client MinioClient, adminClient MinioAdmin, br *models.MakeBucketRequest) error {
+	if br.Encryption != nil {
+		if err := setBucketEncryption(ctx, client, *br.Name, br.Encryption); err != nil {
+			return errors.New(500, "error setting bucket encryption: %v", err)
+		}
+	}
+	if br.Retention != nil {
+		if err := setBucketRetention(ctx, client, *br.Name, br.Retention); err != nil {
+			return errors.New(500, "error setting bucket retention: %v", err)
+		}
+	}
+	if br.Quota != nil && br.Quota.Quota > 0 {
+		if _, err := setBucketQuota(ctx, adminClient, *br.Name, br.Quota); err != nil {
+			return errors.New(500, "error setting bucket quota: %v", err)
+		}
+	}
+	return nil
+}
+
 // setBucketAccessPolicy set the access permissions on an existing bucket.
 func setBucketAccessPolicy(ctx context.Context, client MinioClient, bucketName string, access models.BucketAccess) error {
 	if strings.TrimSpace(bucketName) == "" {
@@ -231,12 +409,21 @@ func getDeleteBucketResponse(session *models.Principal, params user_api.DeleteBu
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
 
+	// a non-empty bucket can only be removed after deleting all of its objects
+	if params.Force != nil && *params.Force {
+		if err := emptyBucket(params.HTTPRequest.Context(), minioClient, bucketName); err != nil {
+			log.Println("error emptying bucket:", err)
+			return err
+		}
+	}
 	return removeBucket(minioClient, bucketName)
 }
 
 // getBucketInfo return bucket information including name, policy access, size and creation date
 func getBucketInfo(client MinioClient, bucketName string) (*models.Bucket, error) {
-	policyStr, err := client.getBucketPolicy(context.Background(), bucketName)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	policyStr, err := client.getBucketPolicy(ctx, bucketName)
 	if err != nil {
 		return nil, err
 	}
@@ -260,6 +447,23 @@ func getBucketInfo(client MinioClient, bucketName string) (*models.Bucket, error
 		CreationDate: "", // to be implemented
 		Size:         0,  // to be implemented
 	}
+	// the settings below are optional, they are left empty if they can't be read, e.g. when the
+	// user isn't allowed to, so the rest of the bucket info is still returned
+	// gateways and older servers don't implement versioning, the status is left empty for them
+	versioning, err := client.getBucketVersioning(ctx, bucketName)
+	if err != nil && minio.ToErrorResponse(err).Code != "NotImplemented" {
+		log.Println("error getting bucket versioning:", err)
+	}
+	bucket.Versioning = versioning.Status
+	if bucket.Encryption, err = getBucketEncryption(ctx, client, bucketName); err != nil {
+		log.Println("error getting bucket encryption:", err)
+	}
+	if bucket.ObjectLocking, bucket.Retention, err = getBucketObjectLocking(ctx, client, bucketName); err != nil {
+		log.Println("error getting bucket object locking:", err)
+	}
+	if bucket.Tags, err = getBucketTags(ctx, client, bucketName); err != nil {
+		log.Println("error getting bucket tags:", err)
+	}
 	return bucket, nil
 }
 
@@ -279,6 +483,19 @@ func getBucketInfoResponse(session *models.Principal, params user_api.BucketInfo
 		log.Println("error getting bucket's info:", err)
 		return nil, err
 	}
+	// the quota is only visible to users allowed to use the admin API,
+	// for the rest of users the bucket info is returned without it
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return bucket, nil
+	}
+	adminClient := adminClient{client: mAdmin}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	if bucket.Quota, err = getBucketQuota(ctx, adminClient, params.Name); err != nil {
+		log.Println("error getting bucket's quota:", err)
+	}
 	return bucket, nil
 
 }
@@ -306,3 +523,35 @@ func consoleAccess2policyAccess(bucketAccess models.BucketAccess) (bucketPolicy
 	}
 	return bucketPolicy
 }
+
+// setBucketVersioning enables or suspends versioning on a bucket, once enabled
+// versioning can't be disabled, only suspended
+func setBucketVersioning(ctx context.Context, client MinioClient, bucketName, status string) error {
+	switch status {
+	case models.SetBucketVersioningRequestStatusEnabled, models.SetBucketVersioningRequestStatusSuspended:
+	default:
+		return errors.New(500, "error versioning status %s not supported", status)
+	}
+	return client.setBucketVersioning(ctx, bucketName, minio.BucketVersioningConfiguration{Status: status})
+}
+
+// getSetBucketVersioningResponse performs setBucketVersioning() with the requested status
+func getSetBucketVersioningResponse(session *models.Principal, params user_api.SetBucketVersioningParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := setBucketVersioning(ctx, minioClient, params.BucketName, *params.Body.Status); err != nil {
+		log.Println("error setting bucket versioning:", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/sse"
+)
+
+func registerBucketEncryptionHandlers(api *operations.ConsoleAPI) {
+	// set bucket encryption
+	api.UserAPISetBucketEncryptionHandler = user_api.SetBucketEncryptionHandlerFunc(func(params user_api.SetBucketEncryptionParams, session *models.Principal) middleware.Responder {
+		if err := getSetBucketEncryptionResponse(session, params); err != nil {
+			return user_api.NewSetBucketEncryptionDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewSetBucketEncryptionNoContent()
+	})
+	// disable bucket encryption
+	api.UserAPIDisableBucketEncryptionHandler = user_api.DisableBucketEncryptionHandlerFunc(func(params user_api.DisableBucketEncryptionParams, session *models.Principal) middleware.Responder {
+		if err := getDisableBucketEncryptionResponse(session, params); err != nil {
+			return user_api.NewDisableBucketEncryptionDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewDisableBucketEncryptionNoContent()
+	})
+}
+
+// encryptionConfigFromModel builds the SSE configuration for the requested encryption type
+func encryptionConfigFromModel(encryption *models.BucketEncryption) (*sse.Configuration, error) {
+	if encryption.Type == nil {
+		return nil, errors.New(500, "error encryption type not in request")
+	}
+	switch *encryption.Type {
+	case models.BucketEncryptionTypeSseS3:
+		return sse.NewConfigurationSSES3(), nil
+	case models.BucketEncryptionTypeSseKms:
+		if encryption.KmsKeyID == "" {
+			return nil, errors.New(500, "error kms key id is required by sse-kms")
+		}
+		return sse.NewConfigurationSSEKMS(encryption.KmsKeyID), nil
+	}
+	return nil, errors.New(500, "error encryption type %s not supported", *encryption.Type)
+}
+
+// encryptionConfigToModel serializes the SSE configuration, nil if there is no encryption rule
+func encryptionConfigToModel(config *sse.Configuration) *models.BucketEncryption {
+	if config == nil || len(config.Rules) == 0 {
+		return nil
+	}
+	apply := config.Rules[0].Apply
+	if apply.SSEAlgorithm == "aws:kms" {
+		return &models.BucketEncryption{
+			Type:     swag.String(models.BucketEncryptionTypeSseKms),
+			KmsKeyID: apply.KmsMasterKeyID,
+		}
+	}
+	return &models.BucketEncryption{Type: swag.String(models.BucketEncryptionTypeSseS3)}
+}
+
+// getBucketEncryption returns the default encryption of a bucket, nil if it isn't encrypted.
+// Gateways and older servers don't implement bucket encryption, it's left empty for them.
+func getBucketEncryption(ctx context.Context, client MinioClient, bucketName string) (*models.BucketEncryption, error) {
+	config, err := client.getBucketEncryption(ctx, bucketName)
+	if err != nil {
+		switch minio.ToErrorResponse(err).Code {
+		case "ServerSideEncryptionConfigurationNotFoundError", "NotImplemented":
+			return nil, nil
+		}
+		return nil, err
+	}
+	return encryptionConfigToModel(config), nil
+}
+
+// setBucketEncryption sets the default encryption used for new objects of the bucket
+func setBucketEncryption(ctx context.Context, client MinioClient, bucketName string, encryption *models.BucketEncryption) error {
+	config, err := encryptionConfigFromModel(encryption)
+	if err != nil {
+		return err
+	}
+	return client.setBucketEncryption(ctx, bucketName, config)
+}
+
+// getSetBucketEncryptionResponse performs setBucketEncryption()
+func getSetBucketEncryptionResponse(session *models.Principal, params user_api.SetBucketEncryptionParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := setBucketEncryption(ctx, minioClient, params.BucketName, params.Body); err != nil {
+		log.Println("error setting bucket encryption:", err)
+		return err
+	}
+	return nil
+}
+
+// getDisableBucketEncryptionResponse removes the default encryption of a bucket
+func getDisableBucketEncryptionResponse(session *models.Principal, params user_api.DisableBucketEncryptionParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := minioClient.removeBucketEncryption(ctx, params.BucketName); err != nil {
+		log.Println("error removing bucket encryption:", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetBucketEncryptionMock func(ctx context.Context, bucketName string) (*sse.Configuration, error)
+var minioSetBucketEncryptionMock func(ctx context.Context, bucketName string, config *sse.Configuration) error
+var minioRemoveBucketEncryptionMock func(ctx context.Context, bucketName string) error
+
+// mock function of getBucketEncryption()
+func (mc minioClientMock) getBucketEncryption(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+	return minioGetBucketEncryptionMock(ctx, bucketName)
+}
+
+// mock function of setBucketEncryption()
+func (mc minioClientMock) setBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) error {
+	return minioSetBucketEncryptionMock(ctx, bucketName, config)
+}
+
+// mock function of removeBucketEncryption()
+func (mc minioClientMock) removeBucketEncryption(ctx context.Context, bucketName string) error {
+	return minioRemoveBucketEncryptionMock(ctx, bucketName)
+}
+
+func TestSetBucketEncryption(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "setBucketEncryption()"
+
+	var gotConfig *sse.Configuration
+	minioSetBucketEncryptionMock = func(ctx context.Context, bucketName string, config *sse.Configuration) error {
+		gotConfig = config
+		return nil
+	}
+
+	// Test-1: setBucketEncryption() sets SSE-S3
+	if err := setBucketEncryption(ctx, minClient, "bucket1", &models.BucketEncryption{Type: swag.String("sse-s3")}); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(sse.NewConfigurationSSES3(), gotConfig)
+
+	// Test-2: setBucketEncryption() sets SSE-KMS with its key
+	if err := setBucketEncryption(ctx, minClient, "bucket1", &models.BucketEncryption{Type: swag.String("sse-kms"), KmsKeyID: "my-key"}); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(sse.NewConfigurationSSEKMS("my-key"), gotConfig)
+
+	// Test-3: setBucketEncryption() requires the key for SSE-KMS
+	if err := setBucketEncryption(ctx, minClient, "bucket1", &models.BucketEncryption{Type: swag.String("sse-kms")}); assert.Error(err) {
+		assert.Equal("error kms key id is required by sse-kms", err.Error())
+	}
+
+	// Test-4: setBucketEncryption() handles errors correctly
+	minioSetBucketEncryptionMock = func(ctx context.Context, bucketName string, config *sse.Configuration) error {
+		return errors.New("error")
+	}
+	if err := setBucketEncryption(ctx, minClient, "bucket1", &models.BucketEncryption{Type: swag.String("sse-s3")}); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
@@ -18,17 +18,33 @@ package restapi
 
 import (
 	"context"
+	"io"
+	"io/ioutil"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
 	"github.com/minio/console/models"
 	"github.com/minio/console/restapi/operations"
 	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/notification"
 )
 
+const (
+	// notificationProbePrefix names the objects written to test a notification rule
+	notificationProbePrefix = "console-notification-probe-"
+	// notificationProbeContent is the content of the probe objects
+	notificationProbeContent = "MinIO Console notification probe"
+	// notificationListenDelay gives MinIO time to register the listener before the probe is written
+	notificationListenDelay = 500 * time.Millisecond
+	// notificationTestTimeout is how long the events of the probe object are waited for
+	notificationTestTimeout = 10 * time.Second
+)
+
 func registerBucketEventsHandlers(api *operations.ConsoleAPI) {
 	// list bucket events
 	api.UserAPIListBucketEventsHandler = user_api.ListBucketEventsHandlerFunc(func(params user_api.ListBucketEventsParams, session *models.Principal) middleware.Responder {
@@ -52,7 +68,77 @@ func registerBucketEventsHandlers(api *operations.ConsoleAPI) {
 		}
 		return user_api.NewDeleteBucketEventNoContent()
 	})
+	// update bucket event
+	api.UserAPIUpdateBucketEventHandler = user_api.UpdateBucketEventHandlerFunc(func(params user_api.UpdateBucketEventParams, session *models.Principal) middleware.Responder {
+		notificationConfig, err := getUpdateBucketEventResponse(session, params)
+		if err != nil {
+			return user_api.NewUpdateBucketEventDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewUpdateBucketEventOK().WithPayload(notificationConfig)
+	})
+	// test bucket event
+	api.UserAPITestBucketEventHandler = user_api.TestBucketEventHandlerFunc(func(params user_api.TestBucketEventParams, session *models.Principal) middleware.Responder {
+		testResult, err := getTestBucketEventResponse(session, params)
+		if err != nil {
+			return user_api.NewTestBucketEventDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewTestBucketEventOK().WithPayload(testResult)
+	})
+}
+
+// notificationEventsToModel generates pretty event names from event types
+func notificationEventsToModel(eventsTypes []notification.EventType) []models.NotificationEventType {
+	var result []models.NotificationEventType
+	for _, eventType := range eventsTypes {
+		var eventTypePretty models.NotificationEventType
+		switch eventType {
+		case notification.ObjectAccessedAll:
+			eventTypePretty = models.NotificationEventTypeGet
+		case notification.ObjectCreatedAll:
+			eventTypePretty = models.NotificationEventTypePut
+		case notification.ObjectRemovedAll:
+			eventTypePretty = models.NotificationEventTypeDelete
+		}
+		result = append(result, eventTypePretty)
+	}
+	return result
+}
+
+// notificationEventsFromModel gets the event types of the pretty event names,
+// all events are returned if none is given.
+func notificationEventsFromModel(events []models.NotificationEventType) []notification.EventType {
+	if len(events) == 0 {
+		return []notification.EventType{notification.ObjectAccessedAll, notification.ObjectCreatedAll, notification.ObjectRemovedAll}
+	}
+	var result []notification.EventType
+	for _, event := range events {
+		switch event {
+		case models.NotificationEventTypeGet:
+			result = append(result, notification.ObjectAccessedAll)
+		case models.NotificationEventTypePut:
+			result = append(result, notification.ObjectCreatedAll)
+		case models.NotificationEventTypeDelete:
+			result = append(result, notification.ObjectRemovedAll)
+		}
+	}
+	return result
+}
 
+// notificationFilters returns the prefix and suffix filters of a rule, the same way minio/mc
+// s3Client.ListNotificationConfigs() serializes configurations
+func notificationFilters(config notification.Config) (prefix, suffix string) {
+	if config.Filter == nil {
+		return
+	}
+	for _, filter := range config.Filter.S3Key.FilterRules {
+		if strings.ToLower(filter.Name) == "prefix" {
+			prefix = filter.Value
+		}
+		if strings.ToLower(filter.Name) == "suffix" {
+			suffix = filter.Value
+		}
+	}
+	return prefix, suffix
 }
 
 // listBucketEvents fetches a list of all events set for a bucket and serializes them for a proper output
@@ -63,61 +149,27 @@ func listBucketEvents(client MinioClient, bucketName string) ([]*models.Notifica
 		return nil, err
 	}
 
-	// Generate pretty event names from event types
-	prettyEventNames := func(eventsTypes []notification.EventType) []models.NotificationEventType {
-		var result []models.NotificationEventType
-		for _, eventType := range eventsTypes {
-			var eventTypePretty models.NotificationEventType
-			switch eventType {
-			case notification.ObjectAccessedAll:
-				eventTypePretty = models.NotificationEventTypeGet
-			case notification.ObjectCreatedAll:
-				eventTypePretty = models.NotificationEventTypePut
-			case notification.ObjectRemovedAll:
-				eventTypePretty = models.NotificationEventTypeDelete
-			}
-			result = append(result, eventTypePretty)
-		}
-		return result
-	}
-	// part of implementation taken from minio/mc
-	// s3Client.ListNotificationConfigs()... to serialize configurations
-	getFilters := func(config notification.Config) (prefix, suffix string) {
-		if config.Filter == nil {
-			return
-		}
-		for _, filter := range config.Filter.S3Key.FilterRules {
-			if strings.ToLower(filter.Name) == "prefix" {
-				prefix = filter.Value
-			}
-			if strings.ToLower(filter.Name) == "suffix" {
-				suffix = filter.Value
-			}
-
-		}
-		return prefix, suffix
-	}
 	for _, embed := range bn.TopicConfigs {
-		prefix, suffix := getFilters(embed.Config)
+		prefix, suffix := notificationFilters(embed.Config)
 		configs = append(configs, &models.NotificationConfig{ID: embed.ID,
 			Arn:    swag.String(embed.Topic),
-			Events: prettyEventNames(embed.Events),
+			Events: notificationEventsToModel(embed.Events),
 			Prefix: prefix,
 			Suffix: suffix})
 	}
 	for _, embed := range bn.QueueConfigs {
-		prefix, suffix := getFilters(embed.Config)
+		prefix, suffix := notificationFilters(embed.Config)
 		configs = append(configs, &models.NotificationConfig{ID: embed.ID,
 			Arn:    swag.String(embed.Queue),
-			Events: prettyEventNames(embed.Events),
+			Events: notificationEventsToModel(embed.Events),
 			Prefix: prefix,
 			Suffix: suffix})
 	}
 	for _, embed := range bn.LambdaConfigs {
-		prefix, suffix := getFilters(embed.Config)
+		prefix, suffix := notificationFilters(embed.Config)
 		configs = append(configs, &models.NotificationConfig{ID: embed.ID,
 			Arn:    swag.String(embed.Lambda),
-			Events: prettyEventNames(embed.Events),
+			Events: notificationEventsToModel(embed.Events),
 			Prefix: prefix,
 			Suffix: suffix})
 	}
@@ -232,3 +284,254 @@ func getDeleteBucketEventsResponse(session *models.Principal, bucketName string,
 	}
 	return nil
 }
+
+// findNotificationConfig returns the rule of the bucket notification configuration set for arn
+// with the given id, nil if there is none.
+func findNotificationConfig(bn *notification.Configuration, arn, id string) *notification.Config {
+	for i := range bn.TopicConfigs {
+		if bn.TopicConfigs[i].Topic == arn && bn.TopicConfigs[i].ID == id {
+			return &bn.TopicConfigs[i].Config
+		}
+	}
+	for i := range bn.QueueConfigs {
+		if bn.QueueConfigs[i].Queue == arn && bn.QueueConfigs[i].ID == id {
+			return &bn.QueueConfigs[i].Config
+		}
+	}
+	for i := range bn.LambdaConfigs {
+		if bn.LambdaConfigs[i].Lambda == arn && bn.LambdaConfigs[i].ID == id {
+			return &bn.LambdaConfigs[i].Config
+		}
+	}
+	return nil
+}
+
+// updateBucketEvent replaces the events, prefix and suffix of a rule keeping its id and target.
+// The whole notification configuration is sent at once so the rule is never missing.
+func updateBucketEvent(ctx context.Context, client MinioClient, bucketName, arn, id string, req *models.NotificationUpdateRequest) (*models.NotificationConfig, error) {
+	bn, err := client.getBucketNotification(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	config := findNotificationConfig(&bn, arn, id)
+	if config == nil {
+		return nil, errors.New(500, "error notification rule %s for %s not found", id, arn)
+	}
+	config.Events = notificationEventsFromModel(req.Events)
+	config.Filter = nil
+	if req.Prefix != "" {
+		config.AddFilterPrefix(req.Prefix)
+	}
+	if req.Suffix != "" {
+		config.AddFilterSuffix(req.Suffix)
+	}
+	if err := client.setBucketNotification(ctx, bucketName, bn); err != nil {
+		return nil, err
+	}
+	return &models.NotificationConfig{
+		ID:     id,
+		Arn:    swag.String(arn),
+		Events: notificationEventsToModel(config.Events),
+		Prefix: req.Prefix,
+		Suffix: req.Suffix,
+	}, nil
+}
+
+// notificationEventCategory gets the pretty event name of any event type, e.g. s3:ObjectCreated:Put is a put
+func notificationEventCategory(eventName string) models.NotificationEventType {
+	switch {
+	case strings.HasPrefix(eventName, "s3:ObjectAccessed:"):
+		return models.NotificationEventTypeGet
+	case strings.HasPrefix(eventName, "s3:ObjectCreated:"):
+		return models.NotificationEventTypePut
+	case strings.HasPrefix(eventName, "s3:ObjectRemoved:"):
+		return models.NotificationEventTypeDelete
+	}
+	return ""
+}
+
+// notificationTargetStatus returns the status MinIO reports for the target of arn, targets are
+// identified by the last two parts of the ARN, e.g. arn:minio:sqs::1:webhook is webhook 1.
+func notificationTargetStatus(ctx context.Context, client MinioAdmin, arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) != 6 {
+		return models.NotificationTestResultTargetStatusUnknown
+	}
+	targetID, targetType := parts[4], parts[5]
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		log.Println("error getting notification targets status:", err)
+		return models.NotificationTestResultTargetStatusUnknown
+	}
+	for _, targets := range info.Services.Notifications {
+		for _, target := range targets[targetType] {
+			if status, ok := target[targetID]; ok {
+				return strings.ToLower(status.Status)
+			}
+		}
+	}
+	return models.NotificationTestResultTargetStatusUnknown
+}
+
+// removeNotificationProbe removes the version of the probe object written, so no delete marker
+// is left on versioned buckets.
+func removeNotificationProbe(ctx context.Context, client MinioClient, bucketName, probeName, versionID string) error {
+	removed, err := removeObjectsBatch(ctx, client, bucketName, []minio.ObjectInfo{{Key: probeName, VersionID: versionID}})
+	if err != nil {
+		return err
+	}
+	if removed[0].Error != "" {
+		return errors.New(500, "error removing probe object: %s", removed[0].Error)
+	}
+	return nil
+}
+
+// testBucketEvent writes a probe object matching the prefix and suffix of the rule, reads it if the
+// rule includes get events and deletes it. Meanwhile the events of the probe are listened to check
+// MinIO published them. The listen API only shows MinIO emitted the events, not that the target got
+// them, so the status MinIO reports for the target is returned too.
+func testBucketEvent(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName, arn, id string, listenDelay, timeout time.Duration) (*models.NotificationTestResult, error) {
+	bn, err := client.getBucketNotification(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	config := findNotificationConfig(&bn, arn, id)
+	if config == nil {
+		return nil, errors.New(500, "error notification rule %s for %s not found", id, arn)
+	}
+	wanted := make(map[models.NotificationEventType]bool)
+	for _, event := range config.Events {
+		wanted[notificationEventCategory(string(event))] = true
+	}
+	prefix, suffix := notificationFilters(*config)
+	probeName := prefix + notificationProbePrefix + strings.ToLower(RandomCharString(8)) + suffix
+
+	listenCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	eventsCh := client.listenBucketNotification(listenCtx, bucketName, probeName, "", []string{
+		string(notification.ObjectAccessedAll),
+		string(notification.ObjectCreatedAll),
+		string(notification.ObjectRemovedAll),
+	})
+	// the listen API has no signal for when the listener is attached, events published before
+	// that are missed and reported as not published
+	time.Sleep(listenDelay)
+
+	result := &models.NotificationTestResult{
+		Object:         probeName,
+		EventsSent:     []models.NotificationEventType{},
+		EventsPublished: []models.NotificationEventType{},
+	}
+	info, err := client.putObject(ctx, bucketName, probeName, strings.NewReader(notificationProbeContent), int64(len(notificationProbeContent)), minio.PutObjectOptions{ContentType: "text/plain"})
+	if err != nil {
+		return nil, err
+	}
+	// the probe is removed below to send its delete event, this only cleans it up if the test fails before
+	probeRemoved := false
+	defer func() {
+		if probeRemoved {
+			return
+		}
+		if err := removeNotificationProbe(ctx, client, bucketName, probeName, info.VersionID); err != nil {
+			log.Println("error removing probe object:", err)
+		}
+	}()
+	if wanted[models.NotificationEventTypePut] {
+		result.EventsSent = append(result.EventsSent, models.NotificationEventTypePut)
+	}
+	if wanted[models.NotificationEventTypeGet] {
+		object, err := client.getObject(ctx, bucketName, probeName, minio.GetObjectOptions{})
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(ioutil.Discard, object)
+		object.Close()
+		if err != nil {
+			return nil, err
+		}
+		result.EventsSent = append(result.EventsSent, models.NotificationEventTypeGet)
+	}
+	if err := removeNotificationProbe(ctx, client, bucketName, probeName, info.VersionID); err != nil {
+		return nil, err
+	}
+	probeRemoved = true
+	if wanted[models.NotificationEventTypeDelete] {
+		result.EventsSent = append(result.EventsSent, models.NotificationEventTypeDelete)
+	}
+
+	received := make(map[models.NotificationEventType]bool)
+	for len(received) < len(result.EventsSent) {
+		notificationInfo, ok := <-eventsCh
+		// the channel is closed once the timeout expires
+		if !ok {
+			break
+		}
+		if notificationInfo.Err != nil {
+			log.Println("error listening to probe events:", notificationInfo.Err)
+			break
+		}
+		for _, record := range notificationInfo.Records {
+			if event := notificationEventCategory(record.EventName); wanted[event] {
+				received[event] = true
+			}
+		}
+	}
+	for _, event := range result.EventsSent {
+		if received[event] {
+			result.EventsPublished = append(result.EventsPublished, event)
+		}
+	}
+	result.TargetStatus = notificationTargetStatus(ctx, adminClient, arn)
+	result.Success = len(result.EventsPublished) == len(result.EventsSent) && result.TargetStatus == models.NotificationTestResultTargetStatusOnline
+	return result, nil
+}
+
+// getUpdateBucketEventResponse calls updateBucketEvent() to replace a bucket event notification
+func getUpdateBucketEventResponse(session *models.Principal, params user_api.UpdateBucketEventParams) (*models.NotificationConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	notificationConfig, err := updateBucketEvent(ctx, minioClient, params.BucketName, params.Arn, params.ID, params.Body)
+	if err != nil {
+		log.Println("error updating bucket event:", err)
+		return nil, err
+	}
+	return notificationConfig, nil
+}
+
+// getTestBucketEventResponse calls testBucketEvent() to test a bucket event notification
+func getTestBucketEventResponse(session *models.Principal, params user_api.TestBucketEventParams) (*models.NotificationTestResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	testResult, err := testBucketEvent(ctx, minioClient, adminClient, params.BucketName, params.Arn, params.ID, notificationListenDelay, notificationTestTimeout)
+	if err != nil {
+		log.Println("error testing bucket event:", err)
+		return nil, err
+	}
+	return testResult, nil
+}
@@ -17,16 +17,21 @@
 package restapi
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	"errors"
 
 	"github.com/go-openapi/swag"
 	"github.com/minio/console/models"
 	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio/pkg/madmin"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,6 +43,19 @@ func (mc minioClientMock) getBucketNotification(ctx context.Context, bucketName
 	return minioGetBucketNotificationMock(ctx, bucketName)
 }
 
+var minioSetBucketNotificationMock func(ctx context.Context, bucketName string, config notification.Configuration) error
+var minioListenBucketNotificationMock func(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info
+
+// mock function of setBucketNotification()
+func (mc minioClientMock) setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error {
+	return minioSetBucketNotificationMock(ctx, bucketName, config)
+}
+
+// mock function of listenBucketNotification()
+func (mc minioClientMock) listenBucketNotification(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info {
+	return minioListenBucketNotificationMock(ctx, bucketName, prefix, suffix, events)
+}
+
 //// Mock mc S3Client functions ////
 var mcAddNotificationConfigMock func(ctx context.Context, arn string, events []string, prefix, suffix string, ignoreExisting bool) *probe.Error
 var mcRemoveNotificationConfigMock func(ctx context.Context, arn string, event string, prefix string, suffix string) *probe.Error
@@ -383,3 +401,181 @@ func TestListBucketEvents(t *testing.T) {
 		assert.Equal("error", err.Error())
 	}
 }
+
+// testNotificationConfiguration returns a notification configuration with a rule for put and delete events
+func testNotificationConfiguration() notification.Configuration {
+	config := notification.NewConfig(notification.NewArn("minio", "sqs", "", "1", "webhook"))
+	config.ID = "rule1"
+	config.AddEvents(notification.ObjectCreatedAll, notification.ObjectRemovedAll)
+	config.AddFilterPrefix("photos/")
+	config.AddFilterSuffix(".jpg")
+	var bn notification.Configuration
+	bn.AddQueue(config)
+	return bn
+}
+
+func TestUpdateBucketEvent(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "updateBucketEvent()"
+
+	minioGetBucketNotificationMock = func(ctx context.Context, bucketName string) (notification.Configuration, error) {
+		return testNotificationConfiguration(), nil
+	}
+	var saved notification.Configuration
+	minioSetBucketNotificationMock = func(ctx context.Context, bucketName string, config notification.Configuration) error {
+		saved = config
+		return nil
+	}
+
+	// Test-1: updateBucketEvent() replaces events and filters of the rule in place
+	req := &models.NotificationUpdateRequest{Events: []models.NotificationEventType{models.NotificationEventTypeGet}, Suffix: ".png"}
+	notificationConfig, err := updateBucketEvent(ctx, minClient, "bucket1", "arn:minio:sqs::1:webhook", "rule1", req)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	if assert.Equal(1, len(saved.QueueConfigs)) {
+		rule := saved.QueueConfigs[0]
+		assert.Equal("rule1", rule.ID)
+		assert.Equal([]notification.EventType{notification.ObjectAccessedAll}, rule.Events)
+		prefix, suffix := notificationFilters(rule.Config)
+		assert.Equal("", prefix)
+		assert.Equal(".png", suffix)
+	}
+	assert.Equal([]models.NotificationEventType{models.NotificationEventTypeGet}, notificationConfig.Events)
+	assert.Equal("arn:minio:sqs::1:webhook", *notificationConfig.Arn)
+
+	// Test-2: updateBucketEvent() fails on a missing rule without saving
+	saved = notification.Configuration{}
+	_, err = updateBucketEvent(ctx, minClient, "bucket1", "arn:minio:sqs::1:webhook", "rule2", req)
+	if assert.Error(err) {
+		assert.Equal("error notification rule rule2 for arn:minio:sqs::1:webhook not found", err.Error())
+	}
+	assert.Equal(0, len(saved.QueueConfigs))
+
+	// Test-3: updateBucketEvent() handles errors correctly
+	minioSetBucketNotificationMock = func(ctx context.Context, bucketName string, config notification.Configuration) error {
+		return errors.New("error")
+	}
+	if _, err = updateBucketEvent(ctx, minClient, "bucket1", "arn:minio:sqs::1:webhook", "rule1", req); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestTestBucketEvent(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := adminClientMock{}
+	function := "testBucketEvent()"
+
+	minioGetBucketNotificationMock = func(ctx context.Context, bucketName string) (notification.Configuration, error) {
+		return testNotificationConfiguration(), nil
+	}
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Services: madmin.Services{Notifications: []map[string][]madmin.TargetIDStatus{
+			{"webhook": {{"1": madmin.Status{Status: "Online"}}}},
+		}}}, nil
+	}
+	// events received by the listener are produced by the put and remove calls
+	var eventsCh chan notification.Info
+	var listenPrefix string
+	minioListenBucketNotificationMock = func(ctx context.Context, bucketName, prefix, suffix string, events []string) <-chan notification.Info {
+		listenPrefix = prefix
+		ch := make(chan notification.Info, 3)
+		go func() {
+			<-ctx.Done()
+			close(ch)
+		}()
+		eventsCh = ch
+		return ch
+	}
+	var putName string
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		putName = objectName
+		eventsCh <- notification.Info{Records: []notification.Event{{EventName: "s3:ObjectCreated:Put"}}}
+		return minio.UploadInfo{Key: objectName, VersionID: "v1"}, nil
+	}
+	getCalled := false
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error) {
+		getCalled = true
+		return objectReaderMock{Reader: bytes.NewReader([]byte(notificationProbeContent))}, nil
+	}
+	var removed []minio.ObjectInfo
+	deliverDelete := true
+	minioRemoveObjectsMock = func(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+		errCh := make(chan minio.RemoveObjectError)
+		for object := range objectsCh {
+			removed = append(removed, object)
+		}
+		if deliverDelete {
+			eventsCh <- notification.Info{Records: []notification.Event{{EventName: "s3:ObjectRemoved:Delete"}}}
+		}
+		close(errCh)
+		return errCh
+	}
+
+	// Test-1: testBucketEvent() writes and removes a probe matching the filters of the rule
+	result, err := testBucketEvent(ctx, minClient, adminClient, "bucket1", "arn:minio:sqs::1:webhook", "rule1", 0, time.Second)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Regexp("^photos/"+notificationProbePrefix+"[a-z0-9]{8}\\.jpg$", result.Object)
+	assert.Equal(result.Object, putName)
+	assert.Equal(result.Object, listenPrefix)
+	assert.False(getCalled)
+	if assert.Equal(1, len(removed)) {
+		assert.Equal("v1", removed[0].VersionID)
+	}
+	assert.Equal([]models.NotificationEventType{models.NotificationEventTypePut, models.NotificationEventTypeDelete}, result.EventsSent)
+	assert.Equal(result.EventsSent, result.EventsPublished)
+	assert.Equal(models.NotificationTestResultTargetStatusOnline, result.TargetStatus)
+	assert.True(result.Success)
+
+	// Test-2: testBucketEvent() reports events not received before the timeout
+	deliverDelete = false
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{}, errors.New("error")
+	}
+	result, err = testBucketEvent(ctx, minClient, adminClient, "bucket1", "arn:minio:sqs::1:webhook", "rule1", 0, 100*time.Millisecond)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]models.NotificationEventType{models.NotificationEventTypePut}, result.EventsPublished)
+	assert.Equal(models.NotificationTestResultTargetStatusUnknown, result.TargetStatus)
+	assert.False(result.Success)
+
+	// Test-3: testBucketEvent() fails on a missing rule
+	_, err = testBucketEvent(ctx, minClient, adminClient, "bucket1", "arn:minio:sqs::2:webhook", "rule1", 0, time.Second)
+	if assert.Error(err) {
+		assert.Equal("error notification rule rule1 for arn:minio:sqs::2:webhook not found", err.Error())
+	}
+
+	// Test-4: testBucketEvent() removes the probe when reading it fails
+	removed = nil
+	minioGetBucketNotificationMock = func(ctx context.Context, bucketName string) (notification.Configuration, error) {
+		bn := testNotificationConfiguration()
+		bn.QueueConfigs[0].Events = append(bn.QueueConfigs[0].Events, notification.ObjectAccessedAll)
+		return bn, nil
+	}
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error) {
+		return nil, errors.New("Access Denied.")
+	}
+	_, err = testBucketEvent(ctx, minClient, adminClient, "bucket1", "arn:minio:sqs::1:webhook", "rule1", 0, time.Second)
+	if assert.Error(err) {
+		assert.Equal("Access Denied.", err.Error())
+	}
+	if assert.Equal(1, len(removed)) {
+		assert.Equal(putName, removed[0].Key)
+		assert.Equal("v1", removed[0].VersionID)
+	}
+
+	// Test-5: notificationEventCategory() groups event names
+	assert.Equal(models.NotificationEventTypeGet, notificationEventCategory("s3:ObjectAccessed:Head"))
+	assert.Equal(models.NotificationEventTypePut, notificationEventCategory("s3:ObjectCreated:CompleteMultipartUpload"))
+	assert.Equal(models.NotificationEventType(""), notificationEventCategory("s3:Replication:OperationFailedReplication"))
+}
@@ -0,0 +1,520 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+const (
+	// lifecycleDateLayout is the format expected for expiration dates
+	lifecycleDateLayout = "2006-01-02"
+	// maxLifecycleRuleIDLength is the longest rule ID accepted by S3
+	maxLifecycleRuleIDLength = 255
+	// lifecycleRuleIDLength is the length of the IDs generated for rules sent without one
+	lifecycleRuleIDLength = 20
+)
+
+func registerBucketLifecycleHandlers(api *operations.ConsoleAPI) {
+	// get bucket lifecycle
+	api.UserAPIGetBucketLifecycleHandler = user_api.GetBucketLifecycleHandlerFunc(func(params user_api.GetBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		lifecycleResponse, err := getBucketLifecycleResponse(session, params)
+		if err != nil {
+			return user_api.NewGetBucketLifecycleDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewGetBucketLifecycleOK().WithPayload(lifecycleResponse)
+	})
+	// replace bucket lifecycle
+	api.UserAPISetBucketLifecycleHandler = user_api.SetBucketLifecycleHandlerFunc(func(params user_api.SetBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		lifecycleResponse, err := getSetBucketLifecycleResponse(session, params)
+		if vErr, ok := err.(lifecycleValidationError); ok {
+			return user_api.NewSetBucketLifecycleBadRequest().WithPayload(vErr.errors)
+		}
+		if err != nil {
+			return user_api.NewSetBucketLifecycleDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewSetBucketLifecycleOK().WithPayload(lifecycleResponse)
+	})
+	// add bucket lifecycle rule
+	api.UserAPIAddBucketLifecycleRuleHandler = user_api.AddBucketLifecycleRuleHandlerFunc(func(params user_api.AddBucketLifecycleRuleParams, session *models.Principal) middleware.Responder {
+		rule, err := getAddBucketLifecycleRuleResponse(session, params)
+		if vErr, ok := err.(lifecycleValidationError); ok {
+			return user_api.NewAddBucketLifecycleRuleBadRequest().WithPayload(vErr.errors)
+		}
+		if err != nil {
+			return user_api.NewAddBucketLifecycleRuleDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewAddBucketLifecycleRuleCreated().WithPayload(rule)
+	})
+	// update bucket lifecycle rule
+	api.UserAPIUpdateBucketLifecycleRuleHandler = user_api.UpdateBucketLifecycleRuleHandlerFunc(func(params user_api.UpdateBucketLifecycleRuleParams, session *models.Principal) middleware.Responder {
+		rule, err := getUpdateBucketLifecycleRuleResponse(session, params)
+		if vErr, ok := err.(lifecycleValidationError); ok {
+			return user_api.NewUpdateBucketLifecycleRuleBadRequest().WithPayload(vErr.errors)
+		}
+		if err != nil {
+			return user_api.NewUpdateBucketLifecycleRuleDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewUpdateBucketLifecycleRuleOK().WithPayload(rule)
+	})
+	// delete bucket lifecycle rule
+	api.UserAPIDeleteBucketLifecycleRuleHandler = user_api.DeleteBucketLifecycleRuleHandlerFunc(func(params user_api.DeleteBucketLifecycleRuleParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteBucketLifecycleRuleResponse(session, params); err != nil {
+			return user_api.NewDeleteBucketLifecycleRuleDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewDeleteBucketLifecycleRuleNoContent()
+	})
+}
+
+// lifecycleValidationError is returned when one or more rules are not valid,
+// it carries the errors of every rule so they can be returned to the client.
+type lifecycleValidationError struct {
+	errors *models.LifecycleValidationErrors
+}
+
+func (e lifecycleValidationError) Error() string {
+	return "error one or more lifecycle rules are not valid"
+}
+
+// parseLifecycleDate parses an expiration date, S3 only accepts dates at midnight UTC
+func parseLifecycleDate(date string) (time.Time, error) {
+	t, err := time.Parse(lifecycleDateLayout, date)
+	if err != nil {
+		// full timestamps are accepted as long as they are at midnight UTC
+		if t, err = time.Parse(time.RFC3339, date); err != nil {
+			return time.Time{}, fmt.Errorf("expiration_date must be formatted as YYYY-MM-DD")
+		}
+	}
+	t = t.UTC()
+	if !t.Equal(t.Truncate(24 * time.Hour)) {
+		return time.Time{}, fmt.Errorf("expiration_date must be at midnight UTC")
+	}
+	return t, nil
+}
+
+// validateLifecycleRule returns every problem found on a rule, an empty list means the rule is valid
+func validateLifecycleRule(rule *models.LifecycleRule) []string {
+	ruleErrors := []string{}
+	if len(rule.ID) > maxLifecycleRuleIDLength {
+		ruleErrors = append(ruleErrors, fmt.Sprintf("id can't be longer than %d characters", maxLifecycleRuleIDLength))
+	}
+	if rule.ExpirationDays < 0 {
+		ruleErrors = append(ruleErrors, "expiration_days must be greater than 0")
+	}
+	if rule.ExpirationDate != "" {
+		if _, err := parseLifecycleDate(rule.ExpirationDate); err != nil {
+			ruleErrors = append(ruleErrors, err.Error())
+		}
+	}
+	if rule.ExpirationDays > 0 && rule.ExpirationDate != "" {
+		ruleErrors = append(ruleErrors, "expiration_days and expiration_date can't be set together")
+	}
+	if rule.ExpiredObjectDeleteMarker && (rule.ExpirationDays > 0 || rule.ExpirationDate != "") {
+		ruleErrors = append(ruleErrors, "expired_object_delete_marker can't be set along with expiration_days or expiration_date")
+	}
+	if rule.NoncurrentExpirationDays < 0 {
+		ruleErrors = append(ruleErrors, "noncurrent_expiration_days must be greater than 0")
+	}
+	if rule.AbortIncompleteMultipartDays < 0 {
+		ruleErrors = append(ruleErrors, "abort_incomplete_multipart_days must be greater than 0")
+	}
+	if rule.AbortIncompleteMultipartDays > 0 && len(rule.Tags) > 0 {
+		ruleErrors = append(ruleErrors, "abort_incomplete_multipart_days can't be used along with a tags filter")
+	}
+	if rule.ExpirationDays <= 0 && rule.ExpirationDate == "" && !rule.ExpiredObjectDeleteMarker &&
+		rule.NoncurrentExpirationDays <= 0 && rule.AbortIncompleteMultipartDays <= 0 {
+		ruleErrors = append(ruleErrors, "at least one action must be set")
+	}
+	tagKeys := map[string]bool{}
+	for _, tag := range rule.Tags {
+		if tag == nil || tag.Key == "" {
+			ruleErrors = append(ruleErrors, "tag keys can't be empty")
+			continue
+		}
+		if tagKeys[tag.Key] {
+			ruleErrors = append(ruleErrors, fmt.Sprintf("tag %s is repeated", tag.Key))
+		}
+		tagKeys[tag.Key] = true
+	}
+	return ruleErrors
+}
+
+// validateLifecycleRules validates every rule and also makes sure that IDs are not repeated,
+// it returns nil when all the rules are valid.
+func validateLifecycleRules(rules []*models.LifecycleRule) *models.LifecycleValidationErrors {
+	validationErrors := &models.LifecycleValidationErrors{Rules: []*models.LifecycleRuleErrors{}}
+	ids := map[string]bool{}
+	for i, rule := range rules {
+		ruleErrors := validateLifecycleRule(rule)
+		if rule.ID != "" && ids[rule.ID] {
+			ruleErrors = append(ruleErrors, fmt.Sprintf("id %s is already used by another rule", rule.ID))
+		}
+		ids[rule.ID] = true
+		if len(ruleErrors) > 0 {
+			validationErrors.Rules = append(validationErrors.Rules, &models.LifecycleRuleErrors{
+				Index:  swag.Int64(int64(i)),
+				ID:     rule.ID,
+				Errors: ruleErrors,
+			})
+		}
+	}
+	if len(validationErrors.Rules) == 0 {
+		return nil
+	}
+	return validationErrors
+}
+
+// lifecycleRuleFromModel converts an already validated rule to its minio-go representation
+func lifecycleRuleFromModel(rule *models.LifecycleRule) lifecycle.Rule {
+	lRule := lifecycle.Rule{
+		ID:     rule.ID,
+		Status: rule.Status,
+	}
+	if lRule.Status == "" {
+		lRule.Status = models.LifecycleRuleStatusEnabled
+	}
+	var tags []lifecycle.Tag
+	for _, tag := range rule.Tags {
+		tags = append(tags, lifecycle.Tag{Key: tag.Key, Value: tag.Value})
+	}
+	// only one of Prefix, Tag and And can be set on the filter
+	switch {
+	case len(tags) == 1 && rule.Prefix == "":
+		lRule.RuleFilter.Tag = tags[0]
+	case len(tags) > 0:
+		lRule.RuleFilter.And = lifecycle.And{Prefix: rule.Prefix, Tags: tags}
+	default:
+		lRule.RuleFilter.Prefix = rule.Prefix
+	}
+	if rule.ExpirationDays > 0 {
+		lRule.Expiration.Days = lifecycle.ExpirationDays(rule.ExpirationDays)
+	}
+	if rule.ExpirationDate != "" {
+		date, _ := parseLifecycleDate(rule.ExpirationDate)
+		lRule.Expiration.Date = lifecycle.ExpirationDate{Time: date}
+	}
+	lRule.Expiration.DeleteMarker = lifecycle.ExpireDeleteMarker(rule.ExpiredObjectDeleteMarker)
+	if rule.NoncurrentExpirationDays > 0 {
+		lRule.NoncurrentVersionExpiration.NoncurrentDays = lifecycle.ExpirationDays(rule.NoncurrentExpirationDays)
+	}
+	if rule.AbortIncompleteMultipartDays > 0 {
+		lRule.AbortIncompleteMultipartUpload.DaysAfterInitiation = lifecycle.ExpirationDays(rule.AbortIncompleteMultipartDays)
+	}
+	return lRule
+}
+
+// lifecycleRuleToModel converts a minio-go rule to the console representation
+func lifecycleRuleToModel(rule lifecycle.Rule) *models.LifecycleRule {
+	mRule := &models.LifecycleRule{
+		ID:                           rule.ID,
+		Status:                       rule.Status,
+		ExpirationDays:               int64(rule.Expiration.Days),
+		ExpiredObjectDeleteMarker:    bool(rule.Expiration.DeleteMarker),
+		NoncurrentExpirationDays:     int64(rule.NoncurrentVersionExpiration.NoncurrentDays),
+		AbortIncompleteMultipartDays: int64(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation),
+		Tags:                         []*models.LifecycleTag{},
+	}
+	if !rule.Expiration.IsDateNull() {
+		mRule.ExpirationDate = rule.Expiration.Date.UTC().Format(lifecycleDateLayout)
+	}
+	// the prefix can come on the deprecated rule field or in any of the filter forms
+	switch {
+	case !rule.RuleFilter.And.IsEmpty():
+		mRule.Prefix = rule.RuleFilter.And.Prefix
+		for _, tag := range rule.RuleFilter.And.Tags {
+			mRule.Tags = append(mRule.Tags, &models.LifecycleTag{Key: tag.Key, Value: tag.Value})
+		}
+	case !rule.RuleFilter.Tag.IsEmpty():
+		mRule.Tags = append(mRule.Tags, &models.LifecycleTag{Key: rule.RuleFilter.Tag.Key, Value: rule.RuleFilter.Tag.Value})
+	case rule.RuleFilter.Prefix != "":
+		mRule.Prefix = rule.RuleFilter.Prefix
+	default:
+		mRule.Prefix = rule.Prefix
+	}
+	return mRule
+}
+
+// getLifecycleRules returns the lifecycle rules of a bucket, a bucket without lifecycle has no rules
+func getLifecycleRules(ctx context.Context, client MinioClient, bucketName string) ([]lifecycle.Rule, error) {
+	config, err := client.getBucketLifecycle(ctx, bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return []lifecycle.Rule{}, nil
+		}
+		return nil, err
+	}
+	return config.Rules, nil
+}
+
+// prepareLifecycleRules sets the defaults of the new rules and validates them along with the rules
+// already stored, errors are reported using the position of the new rules.
+func prepareLifecycleRules(existing []lifecycle.Rule, rules []*models.LifecycleRule) ([]lifecycle.Rule, error) {
+	for _, rule := range rules {
+		if rule.ID == "" {
+			rule.ID = RandomCharString(lifecycleRuleIDLength)
+		}
+		if rule.Status == "" {
+			rule.Status = models.LifecycleRuleStatusEnabled
+		}
+	}
+	if validationErrors := validateLifecycleRules(rules); validationErrors != nil {
+		return nil, lifecycleValidationError{errors: validationErrors}
+	}
+	validationErrors := &models.LifecycleValidationErrors{Rules: []*models.LifecycleRuleErrors{}}
+	for i, rule := range rules {
+		for _, eRule := range existing {
+			if eRule.ID == rule.ID {
+				validationErrors.Rules = append(validationErrors.Rules, &models.LifecycleRuleErrors{
+					Index:  swag.Int64(int64(i)),
+					ID:     rule.ID,
+					Errors: []string{fmt.Sprintf("id %s is already used by another rule", rule.ID)},
+				})
+			}
+		}
+	}
+	if len(validationErrors.Rules) > 0 {
+		return nil, lifecycleValidationError{errors: validationErrors}
+	}
+	lRules := append([]lifecycle.Rule{}, existing...)
+	for _, rule := range rules {
+		lRules = append(lRules, lifecycleRuleFromModel(rule))
+	}
+	return lRules, nil
+}
+
+// setLifecycleRules stores the rules as the bucket lifecycle, no rules removes the lifecycle configuration
+func setLifecycleRules(ctx context.Context, client MinioClient, bucketName string, rules []lifecycle.Rule) error {
+	config := lifecycle.NewConfiguration()
+	config.Rules = rules
+	return client.setBucketLifecycle(ctx, bucketName, config)
+}
+
+// getBucketLifecycle returns the lifecycle rules of a bucket
+func getBucketLifecycle(ctx context.Context, client MinioClient, bucketName string) (*models.BucketLifecycleResponse, error) {
+	rules, err := getLifecycleRules(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	lifecycleResponse := &models.BucketLifecycleResponse{Rules: []*models.LifecycleRule{}}
+	for _, rule := range rules {
+		lifecycleResponse.Rules = append(lifecycleResponse.Rules, lifecycleRuleToModel(rule))
+	}
+	return lifecycleResponse, nil
+}
+
+// replaceBucketLifecycle replaces every lifecycle rule of a bucket
+func replaceBucketLifecycle(ctx context.Context, client MinioClient, bucketName string, rules []*models.LifecycleRule) (*models.BucketLifecycleResponse, error) {
+	lRules, err := prepareLifecycleRules(nil, rules)
+	if err != nil {
+		return nil, err
+	}
+	if err := setLifecycleRules(ctx, client, bucketName, lRules); err != nil {
+		return nil, err
+	}
+	lifecycleResponse := &models.BucketLifecycleResponse{Rules: []*models.LifecycleRule{}}
+	for _, rule := range lRules {
+		lifecycleResponse.Rules = append(lifecycleResponse.Rules, lifecycleRuleToModel(rule))
+	}
+	return lifecycleResponse, nil
+}
+
+// addLifecycleRule appends a rule to the bucket lifecycle
+func addLifecycleRule(ctx context.Context, client MinioClient, bucketName string, rule *models.LifecycleRule) (*models.LifecycleRule, error) {
+	existing, err := getLifecycleRules(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	lRules, err := prepareLifecycleRules(existing, []*models.LifecycleRule{rule})
+	if err != nil {
+		return nil, err
+	}
+	if err := setLifecycleRules(ctx, client, bucketName, lRules); err != nil {
+		return nil, err
+	}
+	return lifecycleRuleToModel(lRules[len(lRules)-1]), nil
+}
+
+// updateLifecycleRule replaces the rule with the given ID keeping its position, transitions are
+// not handled by the console so the ones configured on the rule are preserved.
+func updateLifecycleRule(ctx context.Context, client MinioClient, bucketName, ruleID string, rule *models.LifecycleRule) (*models.LifecycleRule, error) {
+	existing, err := getLifecycleRules(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	index := -1
+	for i, eRule := range existing {
+		if eRule.ID == ruleID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, errors.New(500, "error lifecycle rule %s not found", ruleID)
+	}
+	rule.ID = ruleID
+	others := append(append([]lifecycle.Rule{}, existing[:index]...), existing[index+1:]...)
+	if _, err := prepareLifecycleRules(others, []*models.LifecycleRule{rule}); err != nil {
+		return nil, err
+	}
+	updated := lifecycleRuleFromModel(rule)
+	updated.Transition = existing[index].Transition
+	updated.NoncurrentVersionTransition = existing[index].NoncurrentVersionTransition
+	existing[index] = updated
+	if err := setLifecycleRules(ctx, client, bucketName, existing); err != nil {
+		return nil, err
+	}
+	return lifecycleRuleToModel(updated), nil
+}
+
+// deleteLifecycleRule removes the rule with the given ID from the bucket lifecycle
+func deleteLifecycleRule(ctx context.Context, client MinioClient, bucketName, ruleID string) error {
+	existing, err := getLifecycleRules(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+	var rules []lifecycle.Rule
+	for _, rule := range existing {
+		if rule.ID != ruleID {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == len(existing) {
+		return errors.New(500, "error lifecycle rule %s not found", ruleID)
+	}
+	return setLifecycleRules(ctx, client, bucketName, rules)
+}
+
+// getBucketLifecycleResponse performs getBucketLifecycle() and serializes it to the handler's output
+func getBucketLifecycleResponse(session *models.Principal, params user_api.GetBucketLifecycleParams) (*models.BucketLifecycleResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	lifecycleResponse, err := getBucketLifecycle(ctx, minioClient, params.BucketName)
+	if err != nil {
+		log.Println("error getting bucket lifecycle:", err)
+		return nil, err
+	}
+	return lifecycleResponse, nil
+}
+
+// getSetBucketLifecycleResponse performs replaceBucketLifecycle() and serializes it to the handler's output
+func getSetBucketLifecycleResponse(session *models.Principal, params user_api.SetBucketLifecycleParams) (*models.BucketLifecycleResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	lifecycleResponse, err := replaceBucketLifecycle(ctx, minioClient, params.BucketName, params.Body.Rules)
+	if err != nil {
+		log.Println("error setting bucket lifecycle:", err)
+		return nil, err
+	}
+	return lifecycleResponse, nil
+}
+
+// getAddBucketLifecycleRuleResponse performs addLifecycleRule() and serializes it to the handler's output
+func getAddBucketLifecycleRuleResponse(session *models.Principal, params user_api.AddBucketLifecycleRuleParams) (*models.LifecycleRule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	rule, err := addLifecycleRule(ctx, minioClient, params.BucketName, params.Body)
+	if err != nil {
+		log.Println("error adding bucket lifecycle rule:", err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+// getUpdateBucketLifecycleRuleResponse performs updateLifecycleRule() and serializes it to the handler's output
+func getUpdateBucketLifecycleRuleResponse(session *models.Principal, params user_api.UpdateBucketLifecycleRuleParams) (*models.LifecycleRule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	rule, err := updateLifecycleRule(ctx, minioClient, params.BucketName, params.RuleID, params.Body)
+	if err != nil {
+		log.Println("error updating bucket lifecycle rule:", err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+// getDeleteBucketLifecycleRuleResponse performs deleteLifecycleRule()
+func getDeleteBucketLifecycleRuleResponse(session *models.Principal, params user_api.DeleteBucketLifecycleRuleParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := deleteLifecycleRule(ctx, minioClient, params.BucketName, params.RuleID); err != nil {
+		log.Println("error deleting bucket lifecycle rule:", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetBucketLifecycleMock func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error)
+var minioSetBucketLifecycleMock func(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+
+// mock function of getBucketLifecycle()
+func (mc minioClientMock) getBucketLifecycle(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+	return minioGetBucketLifecycleMock(ctx, bucketName)
+}
+
+// mock function of setBucketLifecycle()
+func (mc minioClientMock) setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+	return minioSetBucketLifecycleMock(ctx, bucketName, config)
+}
+
+func TestValidateLifecycleRules(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: validateLifecycleRules() accepts valid rules
+	rules := []*models.LifecycleRule{
+		{ID: "expire-logs", Prefix: "logs/", ExpirationDays: 30},
+		{ID: "cleanup", ExpirationDate: "2021-01-01", AbortIncompleteMultipartDays: 7},
+		{ID: "versions", Tags: []*models.LifecycleTag{{Key: "tmp", Value: "true"}}, NoncurrentExpirationDays: 1},
+	}
+	assert.Nil(validateLifecycleRules(rules))
+
+	// Test-2: validateLifecycleRules() returns the errors of every invalid rule
+	rules = []*models.LifecycleRule{
+		{ID: "ok", ExpirationDays: 30},
+		{ID: "both", ExpirationDays: 30, ExpirationDate: "2021-01-01"},
+		{ID: "none", Prefix: "logs/"},
+		{ID: "ok", ExpirationDate: "2021-01-01T10:00:00Z", Tags: []*models.LifecycleTag{{Key: "a"}, {Key: "a"}}, AbortIncompleteMultipartDays: 1},
+	}
+	validationErrors := validateLifecycleRules(rules)
+	if assert.NotNil(validationErrors) && assert.Equal(3, len(validationErrors.Rules)) {
+		assert.Equal(int64(1), *validationErrors.Rules[0].Index)
+		assert.Equal([]string{"expiration_days and expiration_date can't be set together"}, validationErrors.Rules[0].Errors)
+		assert.Equal(int64(2), *validationErrors.Rules[1].Index)
+		assert.Equal([]string{"at least one action must be set"}, validationErrors.Rules[1].Errors)
+		assert.Equal(int64(3), *validationErrors.Rules[2].Index)
+		assert.Equal([]string{
+			"expiration_date must be at midnight UTC",
+			"abort_incomplete_multipart_days can't be used along with a tags filter",
+			"tag a is repeated",
+			"id ok is already used by another rule",
+		}, validationErrors.Rules[2].Errors)
+	}
+
+	// Test-3: validateLifecycleRule() rejects delete markers along with an expiration
+	assert.Equal([]string{"expired_object_delete_marker can't be set along with expiration_days or expiration_date"},
+		validateLifecycleRule(&models.LifecycleRule{ExpirationDays: 1, ExpiredObjectDeleteMarker: true}))
+}
+
+func TestLifecycleRuleConversion(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: lifecycleRuleFromModel() uses a tag filter for a single tag
+	rule := lifecycleRuleFromModel(&models.LifecycleRule{ID: "r1", Tags: []*models.LifecycleTag{{Key: "k", Value: "v"}}, ExpirationDays: 10})
+	assert.Equal(lifecycle.Tag{Key: "k", Value: "v"}, rule.RuleFilter.Tag)
+	assert.Equal("Enabled", rule.Status)
+	assert.Equal(lifecycle.ExpirationDays(10), rule.Expiration.Days)
+
+	// Test-2: lifecycleRuleFromModel() uses an and filter for a prefix with tags
+	rule = lifecycleRuleFromModel(&models.LifecycleRule{ID: "r2", Prefix: "logs/", Tags: []*models.LifecycleTag{{Key: "k", Value: "v"}}, ExpirationDate: "2021-01-01"})
+	assert.Equal("logs/", rule.RuleFilter.And.Prefix)
+	assert.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), rule.Expiration.Date.Time)
+
+	// Test-3: lifecycleRuleToModel() converts the rule back
+	mRule := lifecycleRuleToModel(rule)
+	assert.Equal("logs/", mRule.Prefix)
+	assert.Equal("2021-01-01", mRule.ExpirationDate)
+	assert.Equal([]*models.LifecycleTag{{Key: "k", Value: "v"}}, mRule.Tags)
+
+	// Test-4: lifecycleRuleToModel() reads the deprecated prefix field
+	mRule = lifecycleRuleToModel(lifecycle.Rule{ID: "old", Prefix: "tmp/", Status: "Disabled", NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 3}})
+	assert.Equal("tmp/", mRule.Prefix)
+	assert.Equal("Disabled", mRule.Status)
+	assert.Equal(int64(3), mRule.NoncurrentExpirationDays)
+}
+
+func TestBucketLifecycleRules(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	stored := &lifecycle.Configuration{Rules: []lifecycle.Rule{
+		{ID: "expire-logs", Status: "Enabled", RuleFilter: lifecycle.Filter{Prefix: "logs/"}, Expiration: lifecycle.Expiration{Days: 30}},
+		{ID: "archive", Status: "Enabled", Transition: lifecycle.Transition{Days: 10, StorageClass: "WARM"}, Expiration: lifecycle.Expiration{Days: 90}},
+	}}
+	minioGetBucketLifecycleMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return stored, nil
+	}
+	var saved *lifecycle.Configuration
+	minioSetBucketLifecycleMock = func(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+		saved = config
+		return nil
+	}
+
+	// Test-1: getBucketLifecycle() returns the stored rules
+	function := "getBucketLifecycle()"
+	lifecycleResponse, err := getBucketLifecycle(ctx, minClient, "bucket1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(2, len(lifecycleResponse.Rules), fmt.Sprintf("Failed on %s: number of rules is not the same", function))
+	assert.Equal("logs/", lifecycleResponse.Rules[0].Prefix)
+
+	// Test-2: addLifecycleRule() appends the rule generating its ID
+	function = "addLifecycleRule()"
+	rule, err := addLifecycleRule(ctx, minClient, "bucket1", &models.LifecycleRule{AbortIncompleteMultipartDays: 2})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(lifecycleRuleIDLength, len(rule.ID))
+	assert.Equal(3, len(saved.Rules))
+
+	// Test-3: addLifecycleRule() rejects repeated IDs
+	_, err = addLifecycleRule(ctx, minClient, "bucket1", &models.LifecycleRule{ID: "archive", ExpirationDays: 1})
+	if vErr, ok := err.(lifecycleValidationError); assert.True(ok) {
+		assert.Equal([]string{"id archive is already used by another rule"}, vErr.errors.Rules[0].Errors)
+	}
+
+	// Test-4: updateLifecycleRule() keeps the position and the transitions of the rule
+	function = "updateLifecycleRule()"
+	rule, err = updateLifecycleRule(ctx, minClient, "bucket1", "archive", &models.LifecycleRule{ExpirationDays: 120})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("archive", rule.ID)
+	assert.Equal(lifecycle.ExpirationDays(120), saved.Rules[1].Expiration.Days)
+	assert.Equal("WARM", saved.Rules[1].Transition.StorageClass)
+
+	// Test-5: updateLifecycleRule() fails on a missing rule
+	_, err = updateLifecycleRule(ctx, minClient, "bucket1", "missing", &models.LifecycleRule{ExpirationDays: 1})
+	if assert.Error(err) {
+		assert.Equal("error lifecycle rule missing not found", err.Error())
+	}
+
+	// Test-6: deleteLifecycleRule() removes the rule
+	function = "deleteLifecycleRule()"
+	if err = deleteLifecycleRule(ctx, minClient, "bucket1", "expire-logs"); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	if assert.Equal(1, len(saved.Rules)) {
+		assert.Equal("archive", saved.Rules[0].ID)
+	}
+
+	// Test-7: replaceBucketLifecycle() validates every rule before saving
+	saved = nil
+	_, err = replaceBucketLifecycle(ctx, minClient, "bucket1", []*models.LifecycleRule{{ExpirationDays: 1}, {Prefix: "a/"}})
+	if vErr, ok := err.(lifecycleValidationError); assert.True(ok) {
+		assert.Equal(int64(1), *vErr.errors.Rules[0].Index)
+	}
+	assert.Nil(saved)
+
+	// Test-8: getLifecycleRules() returns no rules when the bucket has no lifecycle
+	minioGetBucketLifecycleMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchLifecycleConfiguration"}
+	}
+	lifecycleResponse, err = getBucketLifecycle(ctx, minClient, "bucket1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", "getBucketLifecycle()", err.Error())
+	}
+	assert.Equal(0, len(lifecycleResponse.Rules))
+
+	// Test-9: getBucketLifecycle() handles errors correctly
+	minioGetBucketLifecycleMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return nil, errors.New("error")
+	}
+	if _, err = getBucketLifecycle(ctx, minClient, "bucket1"); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
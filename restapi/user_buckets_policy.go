@@ -0,0 +1,351 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7/pkg/policy"
+	bucketPolicy "github.com/minio/minio/pkg/bucket/policy"
+	minioIAMPolicy "github.com/minio/minio/pkg/iam/policy"
+)
+
+func registerBucketPolicyHandlers(api *operations.ConsoleAPI) {
+	// get bucket policy document
+	api.UserAPIGetBucketPolicyDocumentHandler = user_api.GetBucketPolicyDocumentHandlerFunc(func(params user_api.GetBucketPolicyDocumentParams, session *models.Principal) middleware.Responder {
+		policyDocument, err := getBucketPolicyDocumentResponse(session, params)
+		if err != nil {
+			return user_api.NewGetBucketPolicyDocumentDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewGetBucketPolicyDocumentOK().WithPayload(policyDocument)
+	})
+	// replace bucket policy document
+	api.UserAPISetBucketPolicyDocumentHandler = user_api.SetBucketPolicyDocumentHandlerFunc(func(params user_api.SetBucketPolicyDocumentParams, session *models.Principal) middleware.Responder {
+		policyDocument, err := getSetBucketPolicyDocumentResponse(session, params)
+		if vErr, ok := err.(bucketPolicyValidationError); ok {
+			return user_api.NewSetBucketPolicyDocumentBadRequest().WithPayload(vErr.errors)
+		}
+		if err != nil {
+			return user_api.NewSetBucketPolicyDocumentDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewSetBucketPolicyDocumentOK().WithPayload(policyDocument)
+	})
+	// list anonymous access rules
+	api.UserAPIListBucketAnonymousAccessHandler = user_api.ListBucketAnonymousAccessHandlerFunc(func(params user_api.ListBucketAnonymousAccessParams, session *models.Principal) middleware.Responder {
+		anonymousAccess, err := getListBucketAnonymousAccessResponse(session, params)
+		if err != nil {
+			return user_api.NewListBucketAnonymousAccessDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewListBucketAnonymousAccessOK().WithPayload(anonymousAccess)
+	})
+	// set anonymous access rule
+	api.UserAPISetBucketAnonymousAccessHandler = user_api.SetBucketAnonymousAccessHandlerFunc(func(params user_api.SetBucketAnonymousAccessParams, session *models.Principal) middleware.Responder {
+		anonymousAccess, err := getSetBucketAnonymousAccessResponse(session, params)
+		if err != nil {
+			return user_api.NewSetBucketAnonymousAccessDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewSetBucketAnonymousAccessOK().WithPayload(anonymousAccess)
+	})
+}
+
+// bucketPolicyValidationError is returned when a policy document is not valid,
+// it carries the position of every error so they can be returned to the client.
+type bucketPolicyValidationError struct {
+	errors *models.BucketPolicyValidationErrors
+}
+
+func (e bucketPolicyValidationError) Error() string {
+	return "error the bucket policy is not valid"
+}
+
+// policyError builds an error located at the given byte offset of the document,
+// lines and columns start at 1.
+func policyError(document string, offset int64, message string) *models.BucketPolicyError {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > int64(len(document)) {
+		offset = int64(len(document))
+	}
+	before := document[:offset]
+	line := int64(strings.Count(before, "\n")) + 1
+	column := offset - int64(strings.LastIndex(before, "\n"))
+	return &models.BucketPolicyError{Line: line, Column: column, Message: message}
+}
+
+// jsonPolicyError locates the errors returned while decoding the document, syntax and type
+// errors carry the offset following the wrong value while unknown fields are looked up.
+func jsonPolicyError(document string, err error) *models.BucketPolicyError {
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		return policyError(document, e.Offset-1, e.Error())
+	case *json.UnmarshalTypeError:
+		return policyError(document, e.Offset-1, e.Error())
+	}
+	if strings.HasPrefix(err.Error(), "json: unknown field ") {
+		field := strings.TrimPrefix(err.Error(), "json: unknown field ")
+		return policyError(document, int64(strings.Index(document, field)), err.Error())
+	}
+	return policyError(document, 0, err.Error())
+}
+
+// validateBucketPolicy checks the document against the bucket policy grammar accepted by MinIO.
+// Every statement is validated on its own so all of their errors are returned at once, located
+// at the beginning of the statement.
+func validateBucketPolicy(document, bucketName string) []*models.BucketPolicyError {
+	var doc struct {
+		ID        string
+		Version   string
+		Statement []json.RawMessage
+	}
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return []*models.BucketPolicyError{jsonPolicyError(document, err)}
+	}
+
+	var policyErrors []*models.BucketPolicyError
+	if doc.Version != bucketPolicy.DefaultVersion && doc.Version != "" {
+		policyErrors = append(policyErrors, policyError(document, int64(strings.Index(document, `"Version"`)),
+			fmt.Sprintf("invalid version '%s', only %s is supported", doc.Version, bucketPolicy.DefaultVersion)))
+	}
+	if len(doc.Statement) == 0 {
+		policyErrors = append(policyErrors, policyError(document, 0, "Statement must not be empty"))
+	}
+	offset := 0
+	for i, raw := range doc.Statement {
+		// statements are found in order, each search starts where the previous statement ended
+		start := strings.Index(document[offset:], string(raw))
+		if start < 0 {
+			start = 0
+		}
+		start += offset
+		offset = start + len(raw)
+
+		var statement bucketPolicy.Statement
+		err := json.Unmarshal(raw, &statement)
+		if err == nil {
+			err = statement.Validate(bucketName)
+		}
+		if err != nil {
+			policyErrors = append(policyErrors, policyError(document, int64(start), fmt.Sprintf("Statement %d: %s", i+1, err.Error())))
+		}
+	}
+	if len(policyErrors) > 0 {
+		return policyErrors
+	}
+	// the document is parsed the same way MinIO does as a last check
+	if _, err := bucketPolicy.ParseConfig(strings.NewReader(document), bucketName); err != nil {
+		return []*models.BucketPolicyError{policyError(document, 0, err.Error())}
+	}
+	return nil
+}
+
+// getBucketPolicyDocument returns the policy of the bucket indented to be edited
+func getBucketPolicyDocument(ctx context.Context, client MinioClient, bucketName string) (*models.BucketPolicyDocument, error) {
+	policyStr, err := client.getBucketPolicy(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, []byte(policyStr), "", "  "); err == nil {
+		policyStr = indented.String()
+	}
+	return &models.BucketPolicyDocument{Policy: policyStr}, nil
+}
+
+// setBucketPolicyDocument validates and applies the policy document, an empty document removes
+// the bucket policy.
+func setBucketPolicyDocument(ctx context.Context, client MinioClient, bucketName, document string) error {
+	if strings.TrimSpace(document) == "" {
+		return client.setBucketPolicyWithContext(ctx, bucketName, "")
+	}
+	if policyErrors := validateBucketPolicy(document, bucketName); len(policyErrors) > 0 {
+		return bucketPolicyValidationError{errors: &models.BucketPolicyValidationErrors{Errors: policyErrors}}
+	}
+	return client.setBucketPolicyWithContext(ctx, bucketName, document)
+}
+
+// getBucketAccessPolicy returns the statements of the bucket policy
+func getBucketAccessPolicy(ctx context.Context, client MinioClient, bucketName string) (policy.BucketAccessPolicy, error) {
+	accessPolicy := policy.BucketAccessPolicy{Version: minioIAMPolicy.DefaultVersion}
+	policyStr, err := client.getBucketPolicy(ctx, bucketName)
+	if err != nil {
+		return accessPolicy, err
+	}
+	if policyStr == "" {
+		return accessPolicy, nil
+	}
+	if err := json.Unmarshal([]byte(policyStr), &accessPolicy); err != nil {
+		return accessPolicy, err
+	}
+	return accessPolicy, nil
+}
+
+// anonymousAccessRules returns the anonymous access granted on each prefix sorted by prefix,
+// the whole bucket is returned as an empty prefix.
+func anonymousAccessRules(statements []policy.Statement, bucketName string) *models.BucketAnonymousAccessResponse {
+	rules := []*models.AnonymousAccessRule{}
+	for resource, access := range policy.GetPolicies(statements, bucketName, "") {
+		if access == policy.BucketPolicyNone {
+			continue
+		}
+		prefix := strings.TrimSuffix(strings.TrimPrefix(resource, bucketName+"/"), "*")
+		rules = append(rules, &models.AnonymousAccessRule{Prefix: prefix, Access: swag.String(string(access))})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Prefix < rules[j].Prefix
+	})
+	return &models.BucketAnonymousAccessResponse{Rules: rules}
+}
+
+// listBucketAnonymousAccess returns the anonymous access rules of the bucket policy
+func listBucketAnonymousAccess(ctx context.Context, client MinioClient, bucketName string) (*models.BucketAnonymousAccessResponse, error) {
+	accessPolicy, err := getBucketAccessPolicy(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	return anonymousAccessRules(accessPolicy.Statements, bucketName), nil
+}
+
+// setBucketAnonymousAccess replaces the statements granting anonymous access to the prefix,
+// statements on other prefixes are kept. Implemented like minio/mc/ s3Client.SetAccess()
+func setBucketAnonymousAccess(ctx context.Context, client MinioClient, bucketName string, rule *models.AnonymousAccessRule) (*models.BucketAnonymousAccessResponse, error) {
+	if strings.Contains(rule.Prefix, "*") {
+		return nil, errors.New(500, "error prefix %s can't contain wildcards", rule.Prefix)
+	}
+	accessPolicy, err := getBucketAccessPolicy(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	accessPolicy.Statements = policy.SetPolicy(accessPolicy.Statements, policy.BucketPolicy(*rule.Access), bucketName, rule.Prefix)
+	if len(accessPolicy.Statements) == 0 {
+		if err := client.setBucketPolicyWithContext(ctx, bucketName, ""); err != nil {
+			return nil, err
+		}
+		return anonymousAccessRules(nil, bucketName), nil
+	}
+	policyJSON, err := json.Marshal(accessPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.setBucketPolicyWithContext(ctx, bucketName, string(policyJSON)); err != nil {
+		return nil, err
+	}
+	return anonymousAccessRules(accessPolicy.Statements, bucketName), nil
+}
+
+// getBucketPolicyDocumentResponse performs getBucketPolicyDocument() and serializes it to the handler's output
+func getBucketPolicyDocumentResponse(session *models.Principal, params user_api.GetBucketPolicyDocumentParams) (*models.BucketPolicyDocument, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	policyDocument, err := getBucketPolicyDocument(ctx, minioClient, params.BucketName)
+	if err != nil {
+		log.Println("error getting bucket policy:", err)
+		return nil, err
+	}
+	return policyDocument, nil
+}
+
+// getSetBucketPolicyDocumentResponse performs setBucketPolicyDocument() and returns the stored policy
+func getSetBucketPolicyDocumentResponse(session *models.Principal, params user_api.SetBucketPolicyDocumentParams) (*models.BucketPolicyDocument, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := setBucketPolicyDocument(ctx, minioClient, params.BucketName, params.Body.Policy); err != nil {
+		log.Println("error setting bucket policy:", err)
+		return nil, err
+	}
+	policyDocument, err := getBucketPolicyDocument(ctx, minioClient, params.BucketName)
+	if err != nil {
+		log.Println("error getting bucket policy:", err)
+		return nil, err
+	}
+	return policyDocument, nil
+}
+
+// getListBucketAnonymousAccessResponse performs listBucketAnonymousAccess() and serializes it to the handler's output
+func getListBucketAnonymousAccessResponse(session *models.Principal, params user_api.ListBucketAnonymousAccessParams) (*models.BucketAnonymousAccessResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	anonymousAccess, err := listBucketAnonymousAccess(ctx, minioClient, params.BucketName)
+	if err != nil {
+		log.Println("error listing anonymous access:", err)
+		return nil, err
+	}
+	return anonymousAccess, nil
+}
+
+// getSetBucketAnonymousAccessResponse performs setBucketAnonymousAccess() and serializes it to the handler's output
+func getSetBucketAnonymousAccessResponse(session *models.Principal, params user_api.SetBucketAnonymousAccessParams) (*models.BucketAnonymousAccessResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	anonymousAccess, err := setBucketAnonymousAccess(ctx, minioClient, params.BucketName, params.Body)
+	if err != nil {
+		log.Println("error setting anonymous access:", err)
+		return nil, err
+	}
+	return anonymousAccess, nil
+}
@@ -0,0 +1,195 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBucketPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": ["*"]},
+      "Action": ["s3:GetObject"],
+      "Resource": ["arn:aws:s3:::bucket1/public/*"]
+    }
+  ]
+}`
+
+func TestValidateBucketPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: validateBucketPolicy() accepts a valid document
+	assert.Nil(validateBucketPolicy(testBucketPolicy, "bucket1"))
+
+	// Test-2: validateBucketPolicy() locates syntax errors
+	policyErrors := validateBucketPolicy("{\n  \"Version\": \"2012-10-17\",\n  \"Statement\": [}\n}", "bucket1")
+	if assert.Equal(1, len(policyErrors)) {
+		assert.Equal(int64(3), policyErrors[0].Line)
+		assert.Equal(int64(17), policyErrors[0].Column)
+	}
+
+	// Test-3: validateBucketPolicy() locates unknown fields
+	policyErrors = validateBucketPolicy("{\n  \"Version\": \"2012-10-17\",\n  \"Statements\": []\n}", "bucket1")
+	if assert.Equal(1, len(policyErrors)) {
+		assert.Equal(int64(3), policyErrors[0].Line)
+		assert.Equal(`json: unknown field "Statements"`, policyErrors[0].Message)
+	}
+
+	// Test-4: validateBucketPolicy() returns the errors of every invalid statement at its line
+	document := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allows", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket1/*"},
+    {"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket1/*"},
+    {"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::other/*"}
+  ]
+}`
+	policyErrors = validateBucketPolicy(document, "bucket1")
+	if assert.Equal(2, len(policyErrors)) {
+		assert.Equal(int64(4), policyErrors[0].Line)
+		assert.Equal(int64(5), policyErrors[0].Column)
+		assert.Contains(policyErrors[0].Message, "Statement 1:")
+		assert.Equal(int64(6), policyErrors[1].Line)
+		assert.Contains(policyErrors[1].Message, "Statement 3:")
+	}
+
+	// Test-5: validateBucketPolicy() rejects unsupported versions and empty documents
+	policyErrors = validateBucketPolicy(`{"Version": "2008-10-17", "Statement": []}`, "bucket1")
+	if assert.Equal(2, len(policyErrors)) {
+		assert.Equal("invalid version '2008-10-17', only 2012-10-17 is supported", policyErrors[0].Message)
+		assert.Equal("Statement must not be empty", policyErrors[1].Message)
+	}
+}
+
+func TestBucketPolicyDocument(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	var saved *string
+	minioSetBucketPolicyWithContextMock = func(ctx context.Context, bucketName, policy string) error {
+		saved = &policy
+		return nil
+	}
+
+	// Test-1: setBucketPolicyDocument() applies a valid document as it is
+	function := "setBucketPolicyDocument()"
+	if err := setBucketPolicyDocument(ctx, minClient, "bucket1", testBucketPolicy); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	if assert.NotNil(saved) {
+		assert.Equal(testBucketPolicy, *saved)
+	}
+
+	// Test-2: setBucketPolicyDocument() doesn't apply invalid documents
+	saved = nil
+	err := setBucketPolicyDocument(ctx, minClient, "bucket1", `{"Version": "2012-10-17"`)
+	if vErr, ok := err.(bucketPolicyValidationError); assert.True(ok) {
+		assert.Equal(1, len(vErr.errors.Errors))
+	}
+	assert.Nil(saved)
+
+	// Test-3: setBucketPolicyDocument() removes the policy on an empty document
+	if err = setBucketPolicyDocument(ctx, minClient, "bucket1", " "); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	if assert.NotNil(saved) {
+		assert.Equal("", *saved)
+	}
+
+	// Test-4: getBucketPolicyDocument() indents the stored policy
+	minioGetBucketPolicyMock = func(bucketName string) (string, error) {
+		return `{"Version":"2012-10-17","Statement":[]}`, nil
+	}
+	policyDocument, err := getBucketPolicyDocument(ctx, minClient, "bucket1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", "getBucketPolicyDocument()", err.Error())
+	}
+	assert.Equal("{\n  \"Version\": \"2012-10-17\",\n  \"Statement\": []\n}", policyDocument.Policy)
+}
+
+func TestBucketAnonymousAccess(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	stored := ""
+	minioGetBucketPolicyMock = func(bucketName string) (string, error) {
+		return stored, nil
+	}
+	minioSetBucketPolicyWithContextMock = func(ctx context.Context, bucketName, policy string) error {
+		stored = policy
+		return nil
+	}
+
+	// Test-1: setBucketAnonymousAccess() grants access to a prefix only
+	function := "setBucketAnonymousAccess()"
+	rules, err := setBucketAnonymousAccess(ctx, minClient, "bucket1", &models.AnonymousAccessRule{Prefix: "public/", Access: swag.String(models.AnonymousAccessRuleAccessReadonly)})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]*models.AnonymousAccessRule{{Prefix: "public/", Access: swag.String("readonly")}}, rules.Rules)
+	assert.Nil(validateBucketPolicy(stored, "bucket1"))
+
+	// Test-2: setBucketAnonymousAccess() keeps the rules of other prefixes
+	rules, err = setBucketAnonymousAccess(ctx, minClient, "bucket1", &models.AnonymousAccessRule{Prefix: "uploads/", Access: swag.String(models.AnonymousAccessRuleAccessWriteonly)})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(2, len(rules.Rules))
+
+	// Test-3: listBucketAnonymousAccess() reads the rules back
+	rules, err = listBucketAnonymousAccess(ctx, minClient, "bucket1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", "listBucketAnonymousAccess()", err.Error())
+		return
+	}
+	assert.Equal([]*models.AnonymousAccessRule{
+		{Prefix: "public/", Access: swag.String("readonly")},
+		{Prefix: "uploads/", Access: swag.String("writeonly")},
+	}, rules.Rules)
+
+	// Test-4: setBucketAnonymousAccess() removes the policy along with the last rule
+	_, _ = setBucketAnonymousAccess(ctx, minClient, "bucket1", &models.AnonymousAccessRule{Prefix: "public/", Access: swag.String(models.AnonymousAccessRuleAccessNone)})
+	rules, _ = setBucketAnonymousAccess(ctx, minClient, "bucket1", &models.AnonymousAccessRule{Prefix: "uploads/", Access: swag.String(models.AnonymousAccessRuleAccessNone)})
+	assert.Equal(0, len(rules.Rules))
+	assert.Equal("", stored)
+
+	// Test-5: setBucketAnonymousAccess() rejects wildcards
+	if _, err = setBucketAnonymousAccess(ctx, minClient, "bucket1", &models.AnonymousAccessRule{Prefix: "logs/*", Access: swag.String("readonly")}); assert.Error(err) {
+		assert.Equal("error prefix logs/* can't contain wildcards", err.Error())
+	}
+
+	// Test-6: listBucketAnonymousAccess() handles errors correctly
+	minioGetBucketPolicyMock = func(bucketName string) (string, error) {
+		return "", errors.New("error")
+	}
+	if _, err = listBucketAnonymousAccess(ctx, minClient, "bucket1"); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
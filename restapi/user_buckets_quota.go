@@ -0,0 +1,189 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// bucketQuotasConcurrency is the number of buckets whose quota is read at the same time when listing
+const bucketQuotasConcurrency = 8
+
+func registerBucketQuotaHandlers(api *operations.ConsoleAPI) {
+	// set bucket quota
+	api.UserAPISetBucketQuotaHandler = user_api.SetBucketQuotaHandlerFunc(func(params user_api.SetBucketQuotaParams, session *models.Principal) middleware.Responder {
+		quota, err := getSetBucketQuotaResponse(session, params)
+		if err != nil {
+			return user_api.NewSetBucketQuotaDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewSetBucketQuotaOK().WithPayload(quota)
+	})
+	// list bucket quotas
+	api.AdminAPIListBucketQuotasHandler = admin_api.ListBucketQuotasHandlerFunc(func(params admin_api.ListBucketQuotasParams, session *models.Principal) middleware.Responder {
+		quotasResp, err := getListBucketQuotasResponse(session)
+		if err != nil {
+			return admin_api.NewListBucketQuotasDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return admin_api.NewListBucketQuotasOK().WithPayload(quotasResp)
+	})
+}
+
+// getBucketQuota returns the quota of a bucket, a bucket without quota returns nil
+func getBucketQuota(ctx context.Context, client MinioAdmin, bucketName string) (*models.BucketQuota, error) {
+	quota, err := client.getBucketQuota(ctx, bucketName)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchQuotaConfiguration" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if quota.Quota == 0 {
+		return nil, nil
+	}
+	return &models.BucketQuota{
+		Quota: int64(quota.Quota),
+		Type:  string(quota.Type),
+	}, nil
+}
+
+// setBucketQuota sets or changes the quota of a bucket, a quota of 0 removes it.
+// Quotas are hard by default.
+func setBucketQuota(ctx context.Context, client MinioAdmin, bucketName string, quota *models.BucketQuota) (*models.BucketQuota, error) {
+	if quota.Quota < 0 {
+		return nil, errors.New(500, "error quota must be a positive number of bytes")
+	}
+	if quota.Quota == 0 {
+		return &models.BucketQuota{}, client.setBucketQuota(ctx, bucketName, &madmin.BucketQuota{})
+	}
+	quotaType := quota.Type
+	if quotaType == "" {
+		quotaType = models.BucketQuotaTypeHard
+	}
+	bucketQuota := &madmin.BucketQuota{
+		Quota: uint64(quota.Quota),
+		Type:  madmin.QuotaType(quotaType),
+	}
+	if !bucketQuota.IsValid() {
+		return nil, errors.New(500, "error quota type %s not supported", quotaType)
+	}
+	if err := client.setBucketQuota(ctx, bucketName, bucketQuota); err != nil {
+		return nil, err
+	}
+	return &models.BucketQuota{Quota: quota.Quota, Type: quotaType}, nil
+}
+
+// listBucketQuotas compares the quota of every bucket against its current size, quotas are read by
+// up to bucketQuotasConcurrency workers and a bucket whose quota can't be read reports the error.
+func listBucketQuotas(ctx context.Context, client MinioAdmin) (*models.ListBucketQuotasResponse, error) {
+	buckets, err := getaAcountUsageInfo(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]*models.BucketQuotaUsage, len(buckets))
+	jobsCh := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < bucketQuotasConcurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// each bucket is sent to a single worker so results are written without locking
+			for i := range jobsCh {
+				bucket := buckets[i]
+				usage := &models.BucketQuotaUsage{
+					Name: *bucket.Name,
+					Size: bucket.Size,
+				}
+				quota, err := getBucketQuota(ctx, client, *bucket.Name)
+				if err != nil {
+					log.Printf("error getting quota of bucket %s: %v", *bucket.Name, err)
+					usage.Error = err.Error()
+				}
+				if quota != nil {
+					usage.Quota = quota.Quota
+					usage.Type = quota.Type
+					usage.Exceeded = bucket.Size >= quota.Quota
+				}
+				quotas[i] = usage
+			}
+		}()
+	}
+	for i := range buckets {
+		jobsCh <- i
+	}
+	close(jobsCh)
+	wg.Wait()
+	return &models.ListBucketQuotasResponse{
+		Buckets: quotas,
+		Total:   int64(len(quotas)),
+	}, nil
+}
+
+// getSetBucketQuotaResponse performs setBucketQuota() and serializes it to the handler's output
+func getSetBucketQuotaResponse(session *models.Principal, params user_api.SetBucketQuotaParams) (*models.BucketQuota, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	quota, err := setBucketQuota(ctx, adminClient, params.Name, params.Body)
+	if err != nil {
+		log.Println("error setting bucket quota:", err)
+		return nil, err
+	}
+	return quota, nil
+}
+
+// getListBucketQuotasResponse performs listBucketQuotas() and serializes it to the handler's output
+func getListBucketQuotasResponse(session *models.Principal) (*models.ListBucketQuotasResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	quotas, err := listBucketQuotas(ctx, adminClient)
+	if err != nil {
+		log.Println("error listing bucket quotas:", err)
+		return nil, err
+	}
+	return quotas, nil
+}
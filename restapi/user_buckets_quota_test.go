@@ -0,0 +1,152 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetBucketQuotaMock func(ctx context.Context, bucketName string) (madmin.BucketQuota, error)
+var minioSetBucketQuotaMock func(ctx context.Context, bucketName string, quota *madmin.BucketQuota) error
+
+// mock function of getBucketQuota()
+func (ac adminClientMock) getBucketQuota(ctx context.Context, bucketName string) (madmin.BucketQuota, error) {
+	return minioGetBucketQuotaMock(ctx, bucketName)
+}
+
+// mock function of setBucketQuota()
+func (ac adminClientMock) setBucketQuota(ctx context.Context, bucketName string, quota *madmin.BucketQuota) error {
+	return minioSetBucketQuotaMock(ctx, bucketName, quota)
+}
+
+func TestSetBucketQuota(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := adminClientMock{}
+	function := "setBucketQuota()"
+
+	var gotQuota *madmin.BucketQuota
+	minioSetBucketQuotaMock = func(ctx context.Context, bucketName string, quota *madmin.BucketQuota) error {
+		gotQuota = quota
+		return nil
+	}
+
+	// Test-1: setBucketQuota() sets a hard quota by default
+	quota, err := setBucketQuota(ctx, adminClient, "bucket1", &models.BucketQuota{Quota: 1024})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(&madmin.BucketQuota{Quota: 1024, Type: madmin.HardQuota}, gotQuota)
+	assert.Equal(&models.BucketQuota{Quota: 1024, Type: "hard"}, quota)
+
+	// Test-2: setBucketQuota() sets a fifo quota
+	_, err = setBucketQuota(ctx, adminClient, "bucket1", &models.BucketQuota{Quota: 2048, Type: "fifo"})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(&madmin.BucketQuota{Quota: 2048, Type: madmin.FIFOQuota}, gotQuota)
+
+	// Test-3: setBucketQuota() removes the quota when it's 0
+	_, err = setBucketQuota(ctx, adminClient, "bucket1", &models.BucketQuota{})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(&madmin.BucketQuota{}, gotQuota)
+
+	// Test-4: setBucketQuota() rejects unknown quota types
+	if _, err = setBucketQuota(ctx, adminClient, "bucket1", &models.BucketQuota{Quota: 1, Type: "soft"}); assert.Error(err) {
+		assert.Equal("error quota type soft not supported", err.Error())
+	}
+
+	// Test-5: setBucketQuota() handles errors correctly
+	minioSetBucketQuotaMock = func(ctx context.Context, bucketName string, quota *madmin.BucketQuota) error {
+		return errors.New("error")
+	}
+	if _, err = setBucketQuota(ctx, adminClient, "bucket1", &models.BucketQuota{Quota: 1}); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestListBucketQuotas(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := adminClientMock{}
+	function := "listBucketQuotas()"
+
+	minioAccountUsageInfoMock = func(ctx context.Context) (madmin.AccountUsageInfo, error) {
+		return madmin.AccountUsageInfo{
+			Buckets: []madmin.BucketUsageInfo{
+				{Name: "full", Size: 2048},
+				{Name: "fifo", Size: 100},
+				{Name: "unlimited", Size: 10},
+			},
+		}, nil
+	}
+	minioGetBucketQuotaMock = func(ctx context.Context, bucketName string) (madmin.BucketQuota, error) {
+		switch bucketName {
+		case "full":
+			return madmin.BucketQuota{Quota: 1024, Type: madmin.HardQuota}, nil
+		case "fifo":
+			return madmin.BucketQuota{Quota: 1024, Type: madmin.FIFOQuota}, nil
+		}
+		return madmin.BucketQuota{}, madmin.ErrorResponse{Code: "XMinioAdminNoSuchQuotaConfiguration"}
+	}
+
+	// Test-1: listBucketQuotas() compares the quota of every bucket against its size
+	quotas, err := listBucketQuotas(ctx, adminClient)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	if assert.Equal(int64(3), quotas.Total, fmt.Sprintf("Failed on %s: number of buckets is not the same", function)) {
+		assert.Equal(&models.BucketQuotaUsage{Name: "full", Size: 2048, Quota: 1024, Type: "hard", Exceeded: true}, quotas.Buckets[0])
+		assert.Equal(&models.BucketQuotaUsage{Name: "fifo", Size: 100, Quota: 1024, Type: "fifo"}, quotas.Buckets[1])
+		assert.Equal(&models.BucketQuotaUsage{Name: "unlimited", Size: 10}, quotas.Buckets[2])
+	}
+
+	// Test-2: listBucketQuotas() reports quota errors on their bucket only
+	minioGetBucketQuotaMock = func(ctx context.Context, bucketName string) (madmin.BucketQuota, error) {
+		if bucketName == "fifo" {
+			return madmin.BucketQuota{}, errors.New("error")
+		}
+		return madmin.BucketQuota{Quota: 1024, Type: madmin.HardQuota}, nil
+	}
+	quotas, err = listBucketQuotas(ctx, adminClient)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	if assert.Equal(int64(3), quotas.Total) {
+		assert.Equal(&models.BucketQuotaUsage{Name: "full", Size: 2048, Quota: 1024, Type: "hard", Exceeded: true}, quotas.Buckets[0])
+		assert.Equal(&models.BucketQuotaUsage{Name: "fifo", Size: 100, Error: "error"}, quotas.Buckets[1])
+	}
+
+	// Test-3: listBucketQuotas() handles errors listing the buckets correctly
+	minioAccountUsageInfoMock = func(ctx context.Context) (madmin.AccountUsageInfo, error) {
+		return madmin.AccountUsageInfo{}, errors.New("error")
+	}
+	if _, err = listBucketQuotas(ctx, adminClient); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
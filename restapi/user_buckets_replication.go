@@ -0,0 +1,321 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+func registerBucketReplicationHandlers(api *operations.ConsoleAPI) {
+	// get bucket replication
+	api.UserAPIGetBucketReplicationHandler = user_api.GetBucketReplicationHandlerFunc(func(params user_api.GetBucketReplicationParams, session *models.Principal) middleware.Responder {
+		replicationResponse, err := getBucketReplicationResponse(session, params)
+		if err != nil {
+			return user_api.NewGetBucketReplicationDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewGetBucketReplicationOK().WithPayload(replicationResponse)
+	})
+	// add bucket replication rule
+	api.UserAPIAddBucketReplicationRuleHandler = user_api.AddBucketReplicationRuleHandlerFunc(func(params user_api.AddBucketReplicationRuleParams, session *models.Principal) middleware.Responder {
+		rule, err := getAddBucketReplicationRuleResponse(session, params)
+		if err != nil {
+			return user_api.NewAddBucketReplicationRuleDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewAddBucketReplicationRuleCreated().WithPayload(rule)
+	})
+	// update bucket replication rule
+	api.UserAPIUpdateBucketReplicationRuleHandler = user_api.UpdateBucketReplicationRuleHandlerFunc(func(params user_api.UpdateBucketReplicationRuleParams, session *models.Principal) middleware.Responder {
+		rule, err := getUpdateBucketReplicationRuleResponse(session, params)
+		if err != nil {
+			return user_api.NewUpdateBucketReplicationRuleDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewUpdateBucketReplicationRuleOK().WithPayload(rule)
+	})
+	// delete bucket replication rule
+	api.UserAPIDeleteBucketReplicationRuleHandler = user_api.DeleteBucketReplicationRuleHandlerFunc(func(params user_api.DeleteBucketReplicationRuleParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteBucketReplicationRuleResponse(session, params); err != nil {
+			return user_api.NewDeleteBucketReplicationRuleDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewDeleteBucketReplicationRuleNoContent()
+	})
+}
+
+// getReplicationConfig returns the replication configuration of a bucket,
+// a bucket without replication returns an empty configuration.
+func getReplicationConfig(ctx context.Context, client MinioClient, bucketName string) (replication.Config, error) {
+	config, err := client.getBucketReplication(ctx, bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "ReplicationConfigurationNotFoundError" {
+			return replication.Config{}, nil
+		}
+		return replication.Config{}, err
+	}
+	return config, nil
+}
+
+// replicationRuleToModel serializes a replication rule, the remote bucket ARN is shared by every rule
+func replicationRuleToModel(rule replication.Rule, arn string) *models.BucketReplicationRule {
+	tags := rule.Tags()
+	// a single tag without prefix is stored outside the and filter
+	if tags == "" && rule.Filter.Tag.Key != "" {
+		tags = rule.Filter.Tag.String()
+	}
+	return &models.BucketReplicationRule{
+		ID:                rule.ID,
+		Status:            string(rule.Status),
+		Priority:          int32(rule.Priority),
+		Arn:               arn,
+		DestinationBucket: strings.TrimPrefix(rule.Destination.Bucket, "arn:aws:s3:::"),
+		Prefix:            rule.Prefix(),
+		Tags:              tags,
+		StorageClass:      rule.Destination.StorageClass,
+	}
+}
+
+// replicationRuleOptions builds the options used by minio-go to add or replace a rule
+func replicationRuleOptions(rule *models.BucketReplicationRule, arn string) replication.Options {
+	opts := replication.Options{
+		Op:           replication.AddOption,
+		ID:           rule.ID,
+		Prefix:       rule.Prefix,
+		TagString:    rule.Tags,
+		StorageClass: rule.StorageClass,
+		Arn:          arn,
+	}
+	switch rule.Status {
+	case models.BucketReplicationRuleStatusEnabled:
+		opts.RuleStatus = "enable"
+	case models.BucketReplicationRuleStatusDisabled:
+		opts.RuleStatus = "disable"
+	}
+	if rule.Priority > 0 {
+		opts.Priority = strconv.Itoa(int(rule.Priority))
+	}
+	return opts
+}
+
+// findReplicationRule returns the position of a rule in the configuration or -1 if it doesn't exist
+func findReplicationRule(config replication.Config, ruleID string) int {
+	for i, rule := range config.Rules {
+		if rule.ID == ruleID {
+			return i
+		}
+	}
+	return -1
+}
+
+// getBucketReplication returns the replication rules of a bucket along with their status
+func getBucketReplication(ctx context.Context, client MinioClient, bucketName string) (*models.BucketReplicationResponse, error) {
+	config, err := getReplicationConfig(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	rules := []*models.BucketReplicationRule{}
+	for _, rule := range config.Rules {
+		rules = append(rules, replicationRuleToModel(rule, config.Role))
+	}
+	return &models.BucketReplicationResponse{Rules: rules}, nil
+}
+
+// addReplicationRule appends a rule to the bucket replication configuration. All the rules of a
+// bucket replicate to the same remote bucket, so the ARN is only required for the first rule.
+func addReplicationRule(ctx context.Context, client MinioClient, bucketName string, rule *models.BucketReplicationRule) (*models.BucketReplicationRule, error) {
+	config, err := getReplicationConfig(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	arn := rule.Arn
+	if arn == "" {
+		arn = config.Role
+	}
+	if arn == "" {
+		return nil, errors.New(500, "error remote bucket arn is required")
+	}
+	if config.Role != "" && arn != config.Role {
+		return nil, errors.New(500, "error bucket %s already replicates to %s", bucketName, config.Role)
+	}
+	if rule.ID != "" && findReplicationRule(config, rule.ID) >= 0 {
+		return nil, errors.New(500, "error replication rule %s already exists", rule.ID)
+	}
+	opts := replicationRuleOptions(rule, arn)
+	if opts.RuleStatus == "" {
+		opts.RuleStatus = "enable"
+	}
+	// priority is required by the server and must be unique, new rules get the next free one
+	if opts.Priority == "" {
+		priority := 0
+		for _, r := range config.Rules {
+			if r.Priority > priority {
+				priority = r.Priority
+			}
+		}
+		opts.Priority = strconv.Itoa(priority + 1)
+	}
+	if err := config.AddRule(opts); err != nil {
+		return nil, err
+	}
+	if err := client.setBucketReplication(ctx, bucketName, config); err != nil {
+		return nil, err
+	}
+	// minio-go generates the ID when it's not set, the new rule is always the last one
+	return replicationRuleToModel(config.Rules[len(config.Rules)-1], config.Role), nil
+}
+
+// updateReplicationRule replaces an existing rule, its priority and status are kept when not set
+func updateReplicationRule(ctx context.Context, client MinioClient, bucketName, ruleID string, rule *models.BucketReplicationRule) (*models.BucketReplicationRule, error) {
+	config, err := getReplicationConfig(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	index := findReplicationRule(config, ruleID)
+	if index < 0 {
+		return nil, errors.New(500, "error replication rule %s not found", ruleID)
+	}
+	if rule.Arn != "" && rule.Arn != config.Role {
+		return nil, errors.New(500, "error bucket %s already replicates to %s", bucketName, config.Role)
+	}
+	rule.ID = ruleID
+	opts := replicationRuleOptions(rule, config.Role)
+	if opts.Priority == "" {
+		opts.Priority = strconv.Itoa(config.Rules[index].Priority)
+	}
+	if err := config.AddRule(opts); err != nil {
+		return nil, err
+	}
+	if err := client.setBucketReplication(ctx, bucketName, config); err != nil {
+		return nil, err
+	}
+	return replicationRuleToModel(config.Rules[index], config.Role), nil
+}
+
+// deleteReplicationRule removes a rule, the whole configuration is removed along with its last rule
+func deleteReplicationRule(ctx context.Context, client MinioClient, bucketName, ruleID string) error {
+	config, err := getReplicationConfig(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+	if findReplicationRule(config, ruleID) < 0 {
+		return errors.New(500, "error replication rule %s not found", ruleID)
+	}
+	if len(config.Rules) == 1 {
+		return client.removeBucketReplication(ctx, bucketName)
+	}
+	if err := config.RemoveRule(replication.Options{Op: replication.RemoveOption, ID: ruleID}); err != nil {
+		return err
+	}
+	return client.setBucketReplication(ctx, bucketName, config)
+}
+
+// getBucketReplicationResponse performs getBucketReplication() and serializes it to the handler's output
+func getBucketReplicationResponse(session *models.Principal, params user_api.GetBucketReplicationParams) (*models.BucketReplicationResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	replicationResponse, err := getBucketReplication(ctx, minioClient, params.BucketName)
+	if err != nil {
+		log.Println("error getting bucket replication:", err)
+		return nil, err
+	}
+	return replicationResponse, nil
+}
+
+// getAddBucketReplicationRuleResponse performs addReplicationRule() and serializes it to the handler's output
+func getAddBucketReplicationRuleResponse(session *models.Principal, params user_api.AddBucketReplicationRuleParams) (*models.BucketReplicationRule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	rule, err := addReplicationRule(ctx, minioClient, params.BucketName, params.Body)
+	if err != nil {
+		log.Println("error adding bucket replication rule:", err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+// getUpdateBucketReplicationRuleResponse performs updateReplicationRule() and serializes it to the handler's output
+func getUpdateBucketReplicationRuleResponse(session *models.Principal, params user_api.UpdateBucketReplicationRuleParams) (*models.BucketReplicationRule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	rule, err := updateReplicationRule(ctx, minioClient, params.BucketName, params.RuleID, params.Body)
+	if err != nil {
+		log.Println("error updating bucket replication rule:", err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+// getDeleteBucketReplicationRuleResponse performs deleteReplicationRule()
+func getDeleteBucketReplicationRuleResponse(session *models.Principal, params user_api.DeleteBucketReplicationRuleParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := deleteReplicationRule(ctx, minioClient, params.BucketName, params.RuleID); err != nil {
+		log.Println("error deleting bucket replication rule:", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetBucketReplicationMock func(ctx context.Context, bucketName string) (replication.Config, error)
+var minioSetBucketReplicationMock func(ctx context.Context, bucketName string, config replication.Config) error
+var minioRemoveBucketReplicationMock func(ctx context.Context, bucketName string) error
+
+// mock function of getBucketReplication()
+func (mc minioClientMock) getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
+	return minioGetBucketReplicationMock(ctx, bucketName)
+}
+
+// mock function of setBucketReplication()
+func (mc minioClientMock) setBucketReplication(ctx context.Context, bucketName string, config replication.Config) error {
+	return minioSetBucketReplicationMock(ctx, bucketName, config)
+}
+
+// mock function of removeBucketReplication()
+func (mc minioClientMock) removeBucketReplication(ctx context.Context, bucketName string) error {
+	return minioRemoveBucketReplicationMock(ctx, bucketName)
+}
+
+func TestBucketReplicationRules(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	arn := "arn:minio:replication::1234:target"
+
+	stored := replication.Config{}
+	minioGetBucketReplicationMock = func(ctx context.Context, bucketName string) (replication.Config, error) {
+		if len(stored.Rules) == 0 {
+			return replication.Config{}, minio.ErrorResponse{Code: "ReplicationConfigurationNotFoundError"}
+		}
+		return stored, nil
+	}
+	minioSetBucketReplicationMock = func(ctx context.Context, bucketName string, config replication.Config) error {
+		stored = config
+		return nil
+	}
+	removed := false
+	minioRemoveBucketReplicationMock = func(ctx context.Context, bucketName string) error {
+		removed = true
+		stored = replication.Config{}
+		return nil
+	}
+
+	// Test-1: getBucketReplication() returns no rules when the bucket has no replication
+	function := "getBucketReplication()"
+	replicationResponse, err := getBucketReplication(ctx, minClient, "source")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(0, len(replicationResponse.Rules))
+
+	// Test-2: addReplicationRule() requires an ARN for the first rule
+	function = "addReplicationRule()"
+	if _, err = addReplicationRule(ctx, minClient, "source", &models.BucketReplicationRule{Prefix: "docs/"}); assert.Error(err) {
+		assert.Equal("error remote bucket arn is required", err.Error())
+	}
+
+	// Test-3: addReplicationRule() enables the rule and sets its priority
+	rule, err := addReplicationRule(ctx, minClient, "source", &models.BucketReplicationRule{ID: "docs", Arn: arn, Prefix: "docs/"})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(&models.BucketReplicationRule{
+		ID:                "docs",
+		Status:            "Enabled",
+		Priority:          1,
+		Arn:               arn,
+		DestinationBucket: "target",
+		Prefix:            "docs/",
+	}, rule)
+	assert.Equal(arn, stored.Role)
+
+	// Test-4: addReplicationRule() reuses the ARN and generates the ID
+	rule, err = addReplicationRule(ctx, minClient, "source", &models.BucketReplicationRule{Tags: "env=prod", Status: "Disabled"})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.NotEmpty(rule.ID)
+	assert.Equal(int32(2), rule.Priority)
+	assert.Equal("env=prod", rule.Tags)
+	assert.Equal("Disabled", rule.Status)
+
+	// Test-5: addReplicationRule() rejects repeated IDs and other remote buckets
+	if _, err = addReplicationRule(ctx, minClient, "source", &models.BucketReplicationRule{ID: "docs"}); assert.Error(err) {
+		assert.Equal("error replication rule docs already exists", err.Error())
+	}
+	if _, err = addReplicationRule(ctx, minClient, "source", &models.BucketReplicationRule{Arn: "arn:minio:replication::5678:other"}); assert.Error(err) {
+		assert.Equal(fmt.Sprintf("error bucket source already replicates to %s", arn), err.Error())
+	}
+
+	// Test-6: updateReplicationRule() keeps the priority and status of the rule
+	function = "updateReplicationRule()"
+	rule, err = updateReplicationRule(ctx, minClient, "source", "docs", &models.BucketReplicationRule{Prefix: "documents/", StorageClass: "STANDARD"})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("documents/", rule.Prefix)
+	assert.Equal(int32(1), rule.Priority)
+	assert.Equal("Enabled", rule.Status)
+	assert.Equal("STANDARD", stored.Rules[0].Destination.StorageClass)
+
+	// Test-7: updateReplicationRule() fails on a missing rule
+	if _, err = updateReplicationRule(ctx, minClient, "source", "missing", &models.BucketReplicationRule{}); assert.Error(err) {
+		assert.Equal("error replication rule missing not found", err.Error())
+	}
+
+	// Test-8: deleteReplicationRule() removes a rule keeping the rest
+	function = "deleteReplicationRule()"
+	if err = deleteReplicationRule(ctx, minClient, "source", "docs"); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(1, len(stored.Rules))
+	assert.False(removed)
+
+	// Test-9: deleteReplicationRule() removes the configuration along with its last rule
+	if err = deleteReplicationRule(ctx, minClient, "source", stored.Rules[0].ID); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.True(removed)
+
+	// Test-10: getBucketReplication() handles errors correctly
+	minioGetBucketReplicationMock = func(ctx context.Context, bucketName string) (replication.Config, error) {
+		return replication.Config{}, errors.New("error")
+	}
+	if _, err = getBucketReplication(ctx, minClient, "source"); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
@@ -0,0 +1,108 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+)
+
+func registerBucketRetentionHandlers(api *operations.ConsoleAPI) {
+	// set bucket default retention
+	api.UserAPISetBucketRetentionHandler = user_api.SetBucketRetentionHandlerFunc(func(params user_api.SetBucketRetentionParams, session *models.Principal) middleware.Responder {
+		if err := getSetBucketRetentionResponse(session, params); err != nil {
+			return user_api.NewSetBucketRetentionDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewSetBucketRetentionNoContent()
+	})
+}
+
+// getBucketObjectLocking returns whether object locking is enabled on a bucket and its default retention,
+// object locking can only be enabled when the bucket is created.
+func getBucketObjectLocking(ctx context.Context, client MinioClient, bucketName string) (bool, *models.BucketRetention, error) {
+	objectLock, mode, validity, unit, err := client.getObjectLockConfig(ctx, bucketName)
+	if err != nil {
+		switch minio.ToErrorResponse(err).Code {
+		case "ObjectLockConfigurationNotFoundError", "NotImplemented":
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	if mode == nil || validity == nil || unit == nil {
+		return objectLock == "Enabled", nil, nil
+	}
+	return objectLock == "Enabled", &models.BucketRetention{
+		Mode:     swag.String(strings.ToLower(string(*mode))),
+		Validity: swag.Int32(int32(*validity)),
+		Unit:     strings.ToLower(string(*unit)),
+	}, nil
+}
+
+// setBucketRetention sets the default retention mode and period applied to new objects of the bucket
+func setBucketRetention(ctx context.Context, client MinioClient, bucketName string, retention *models.BucketRetention) error {
+	if retention.Mode == nil || retention.Validity == nil {
+		return errors.New(500, "error retention mode and validity are required")
+	}
+	mode := minio.RetentionMode(strings.ToUpper(*retention.Mode))
+	if !mode.IsValid() {
+		return errors.New(500, "error retention mode %s not supported", *retention.Mode)
+	}
+	if *retention.Validity < 1 {
+		return errors.New(500, "error retention validity must be at least 1")
+	}
+	validity := uint(*retention.Validity)
+	unit := minio.Days
+	switch retention.Unit {
+	case "", models.BucketRetentionUnitDays:
+	case models.BucketRetentionUnitYears:
+		unit = minio.Years
+	default:
+		return errors.New(500, "error retention unit %s not supported", retention.Unit)
+	}
+	return client.setObjectLockConfig(ctx, bucketName, &mode, &validity, &unit)
+}
+
+// getSetBucketRetentionResponse performs setBucketRetention()
+func getSetBucketRetentionResponse(session *models.Principal, params user_api.SetBucketRetentionParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := setBucketRetention(ctx, minioClient, params.BucketName, params.Body); err != nil {
+		log.Println("error setting bucket retention:", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetObjectLockConfigMock func(ctx context.Context, bucketName string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error)
+var minioSetObjectLockConfigMock func(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error
+
+// mock function of getObjectLockConfig()
+func (mc minioClientMock) getObjectLockConfig(ctx context.Context, bucketName string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+	return minioGetObjectLockConfigMock(ctx, bucketName)
+}
+
+// mock function of setObjectLockConfig()
+func (mc minioClientMock) setObjectLockConfig(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+	return minioSetObjectLockConfigMock(ctx, bucketName, mode, validity, unit)
+}
+
+func TestSetBucketRetention(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "setBucketRetention()"
+
+	var gotMode minio.RetentionMode
+	var gotValidity uint
+	var gotUnit minio.ValidityUnit
+	minioSetObjectLockConfigMock = func(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+		gotMode, gotValidity, gotUnit = *mode, *validity, *unit
+		return nil
+	}
+
+	// Test-1: setBucketRetention() uses days by default
+	if err := setBucketRetention(ctx, minClient, "bucket1", &models.BucketRetention{Mode: swag.String("governance"), Validity: swag.Int32(15)}); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(minio.Governance, gotMode)
+	assert.Equal(uint(15), gotValidity)
+	assert.Equal(minio.Days, gotUnit)
+
+	// Test-2: setBucketRetention() sets a compliance retention in years
+	if err := setBucketRetention(ctx, minClient, "bucket1", &models.BucketRetention{Mode: swag.String("compliance"), Validity: swag.Int32(1), Unit: "years"}); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(minio.Compliance, gotMode)
+	assert.Equal(minio.Years, gotUnit)
+
+	// Test-3: setBucketRetention() rejects invalid modes and validities
+	if err := setBucketRetention(ctx, minClient, "bucket1", &models.BucketRetention{Mode: swag.String("legal"), Validity: swag.Int32(1)}); assert.Error(err) {
+		assert.Equal("error retention mode legal not supported", err.Error())
+	}
+	if err := setBucketRetention(ctx, minClient, "bucket1", &models.BucketRetention{Mode: swag.String("governance"), Validity: swag.Int32(0)}); assert.Error(err) {
+		assert.Equal("error retention validity must be at least 1", err.Error())
+	}
+
+	// Test-4: setBucketRetention() handles errors correctly
+	minioSetObjectLockConfigMock = func(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+		return errors.New("error")
+	}
+	if err := setBucketRetention(ctx, minClient, "bucket1", &models.BucketRetention{Mode: swag.String("governance"), Validity: swag.Int32(1)}); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
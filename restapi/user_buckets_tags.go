@@ -0,0 +1,196 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// bucketTagsConcurrency is the number of buckets whose tags are read at the same time when listing
+const bucketTagsConcurrency = 8
+
+func registerBucketTagsHandlers(api *operations.ConsoleAPI) {
+	// get bucket tags
+	api.UserAPIGetBucketTagsHandler = user_api.GetBucketTagsHandlerFunc(func(params user_api.GetBucketTagsParams, session *models.Principal) middleware.Responder {
+		bucketTags, err := getBucketTagsResponse(session, params)
+		if err != nil {
+			return user_api.NewGetBucketTagsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewGetBucketTagsOK().WithPayload(bucketTags)
+	})
+	// replace bucket tags
+	api.UserAPIPutBucketTagsHandler = user_api.PutBucketTagsHandlerFunc(func(params user_api.PutBucketTagsParams, session *models.Principal) middleware.Responder {
+		bucketTags, err := getPutBucketTagsResponse(session, params)
+		if err != nil {
+			return user_api.NewPutBucketTagsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewPutBucketTagsOK().WithPayload(bucketTags)
+	})
+	// delete bucket tags
+	api.UserAPIDeleteBucketTagsHandler = user_api.DeleteBucketTagsHandlerFunc(func(params user_api.DeleteBucketTagsParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteBucketTagsResponse(session, params); err != nil {
+			return user_api.NewDeleteBucketTagsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewDeleteBucketTagsNoContent()
+	})
+}
+
+// getBucketTags returns the tags of a bucket, empty if it has none.
+// Gateways and older servers don't implement bucket tagging, it's left empty for them.
+func getBucketTags(ctx context.Context, client MinioClient, bucketName string) (map[string]string, error) {
+	bucketTags, err := client.getBucketTagging(ctx, bucketName)
+	if err != nil {
+		switch minio.ToErrorResponse(err).Code {
+		case "NoSuchTagSet", "NotImplemented":
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	return bucketTags.ToMap(), nil
+}
+
+// putBucketTags replaces the tag set of a bucket, an empty set removes every tag
+func putBucketTags(ctx context.Context, client MinioClient, bucketName string, tagMap map[string]string) (*models.BucketTags, error) {
+	if len(tagMap) == 0 {
+		if err := client.removeBucketTagging(ctx, bucketName); err != nil {
+			return nil, err
+		}
+		return &models.BucketTags{Tags: map[string]string{}}, nil
+	}
+	// tags are validated against the S3 limits before sending them
+	bucketTags, err := tags.MapToBucketTags(tagMap)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.setBucketTagging(ctx, bucketName, bucketTags); err != nil {
+		return nil, err
+	}
+	return &models.BucketTags{Tags: bucketTags.ToMap()}, nil
+}
+
+// addBucketsTags sets the tags of every bucket, a bucket whose tags can't be read is left without them.
+// Tags are read by up to bucketTagsConcurrency workers.
+func addBucketsTags(ctx context.Context, client MinioClient, buckets []*models.Bucket) {
+	bucketsCh := make(chan *models.Bucket)
+	var wg sync.WaitGroup
+	for w := 0; w < bucketTagsConcurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// each bucket is sent to a single worker so tags are set without locking
+			for bucket := range bucketsCh {
+				bucketTags, err := getBucketTags(ctx, client, *bucket.Name)
+				if err != nil {
+					log.Printf("error getting tags of bucket %s: %v", *bucket.Name, err)
+					continue
+				}
+				bucket.Tags = bucketTags
+			}
+		}()
+	}
+	for _, bucket := range buckets {
+		bucketsCh <- bucket
+	}
+	close(bucketsCh)
+	wg.Wait()
+}
+
+// filterBucketsByTag returns the buckets having the tag key, with the given value if it's not nil
+func filterBucketsByTag(buckets []*models.Bucket, key string, value *string) []*models.Bucket {
+	filtered := []*models.Bucket{}
+	for _, bucket := range buckets {
+		tagValue, ok := bucket.Tags[key]
+		if !ok || (value != nil && tagValue != *value) {
+			continue
+		}
+		filtered = append(filtered, bucket)
+	}
+	return filtered
+}
+
+// getBucketTagsResponse performs getBucketTags() and serializes it to the handler's output
+func getBucketTagsResponse(session *models.Principal, params user_api.GetBucketTagsParams) (*models.BucketTags, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	bucketTags, err := getBucketTags(ctx, minioClient, params.BucketName)
+	if err != nil {
+		log.Println("error getting bucket tags:", err)
+		return nil, err
+	}
+	return &models.BucketTags{Tags: bucketTags}, nil
+}
+
+// getPutBucketTagsResponse performs putBucketTags() and serializes it to the handler's output
+func getPutBucketTagsResponse(session *models.Principal, params user_api.PutBucketTagsParams) (*models.BucketTags, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	bucketTags, err := putBucketTags(ctx, minioClient, params.BucketName, params.Body.Tags)
+	if err != nil {
+		log.Println("error setting bucket tags:", err)
+		return nil, err
+	}
+	return bucketTags, nil
+}
+
+// getDeleteBucketTagsResponse removes every tag of a bucket
+func getDeleteBucketTagsResponse(session *models.Principal, params user_api.DeleteBucketTagsParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := minioClient.removeBucketTagging(ctx, params.BucketName); err != nil {
+		log.Println("error removing bucket tags:", err)
+		return err
+	}
+	return nil
+}
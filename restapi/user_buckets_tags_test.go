@@ -0,0 +1,144 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetBucketTaggingMock func(ctx context.Context, bucketName string) (*tags.Tags, error)
+var minioSetBucketTaggingMock func(ctx context.Context, bucketName string, btags *tags.Tags) error
+var minioRemoveBucketTaggingMock func(ctx context.Context, bucketName string) error
+
+// mock function of getBucketTagging()
+func (mc minioClientMock) getBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error) {
+	return minioGetBucketTaggingMock(ctx, bucketName)
+}
+
+// mock function of setBucketTagging()
+func (mc minioClientMock) setBucketTagging(ctx context.Context, bucketName string, btags *tags.Tags) error {
+	return minioSetBucketTaggingMock(ctx, bucketName, btags)
+}
+
+// mock function of removeBucketTagging()
+func (mc minioClientMock) removeBucketTagging(ctx context.Context, bucketName string) error {
+	return minioRemoveBucketTaggingMock(ctx, bucketName)
+}
+
+func TestBucketTags(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	// Test-1: getBucketTags() returns no tags when the bucket has none
+	function := "getBucketTags()"
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchTagSet"}
+	}
+	bucketTags, err := getBucketTags(ctx, minClient, "bucket1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(map[string]string{}, bucketTags)
+
+	// Test-2: getBucketTags() handles errors correctly
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return nil, errors.New("error")
+	}
+	if _, err = getBucketTags(ctx, minClient, "bucket1"); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+
+	// Test-3: putBucketTags() replaces the tag set
+	function = "putBucketTags()"
+	var saved *tags.Tags
+	minioSetBucketTaggingMock = func(ctx context.Context, bucketName string, btags *tags.Tags) error {
+		saved = btags
+		return nil
+	}
+	response, err := putBucketTags(ctx, minClient, "bucket1", map[string]string{"cost-center": "cc-42", "team": "data"})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(map[string]string{"cost-center": "cc-42", "team": "data"}, saved.ToMap())
+	assert.Equal(map[string]string{"cost-center": "cc-42", "team": "data"}, response.Tags)
+
+	// Test-4: putBucketTags() removes the tags on an empty set
+	removed := false
+	minioRemoveBucketTaggingMock = func(ctx context.Context, bucketName string) error {
+		removed = true
+		return nil
+	}
+	if _, err = putBucketTags(ctx, minClient, "bucket1", map[string]string{}); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.True(removed)
+
+	// Test-5: putBucketTags() validates the tags before sending them
+	if _, err = putBucketTags(ctx, minClient, "bucket1", map[string]string{"": "empty"}); assert.Error(err) {
+		assert.Equal("The TagKey you have provided is invalid", err.Error())
+	}
+}
+
+func TestFilterBucketsByTag(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	bucketTags := map[string]map[string]string{
+		"analytics": {"team": "data", "cost-center": "cc-42"},
+		"web":       {"team": "frontend"},
+	}
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		if bucketName == "broken" {
+			return nil, errors.New("error")
+		}
+		if tagMap, ok := bucketTags[bucketName]; ok {
+			return tags.MapToBucketTags(tagMap)
+		}
+		return nil, minio.ErrorResponse{Code: "NoSuchTagSet"}
+	}
+	buckets := []*models.Bucket{{Name: swag.String("analytics")}, {Name: swag.String("web")}, {Name: swag.String("logs")}, {Name: swag.String("broken")}}
+
+	// Test-1: addBucketsTags() sets the tags of every bucket skipping the failing ones
+	addBucketsTags(ctx, minClient, buckets)
+	assert.Equal(bucketTags["analytics"], buckets[0].Tags)
+	assert.Equal(map[string]string{}, buckets[2].Tags)
+	assert.Nil(buckets[3].Tags)
+
+	// Test-2: filterBucketsByTag() matches any value of the key
+	filtered := filterBucketsByTag(buckets, "team", nil)
+	if assert.Equal(2, len(filtered)) {
+		assert.Equal("analytics", *filtered[0].Name)
+		assert.Equal("web", *filtered[1].Name)
+	}
+
+	// Test-3: filterBucketsByTag() matches the value when set
+	filtered = filterBucketsByTag(buckets, "team", swag.String("frontend"))
+	if assert.Equal(1, len(filtered)) {
+		assert.Equal("web", *filtered[0].Name)
+	}
+	assert.Equal(0, len(filterBucketsByTag(buckets, "owner", nil)))
+}
@@ -27,16 +27,20 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/minio/console/models"
 	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/pkg/madmin"
 	"github.com/stretchr/testify/assert"
 )
 
 // assigning mock at runtime instead of compile time
 var minioListBucketsWithContextMock func(ctx context.Context) ([]minio.BucketInfo, error)
-var minioMakeBucketWithContextMock func(ctx context.Context, bucketName, location string) error
+var minioMakeBucketWithContextMock func(ctx context.Context, bucketName, location string, objectLocking bool) error
 var minioSetBucketPolicyWithContextMock func(ctx context.Context, bucketName, policy string) error
 var minioRemoveBucketMock func(bucketName string) error
 var minioGetBucketPolicyMock func(bucketName string) (string, error)
+var minioGetBucketVersioningMock func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
+var minioSetBucketVersioningMock func(ctx context.Context, bucketName string, config minio.BucketVersioningConfiguration) error
 
 // Define a mock struct of minio Client interface implementation
 type minioClientMock struct {
@@ -48,8 +52,8 @@ func (mc minioClientMock) listBucketsWithContext(ctx context.Context) ([]minio.B
 }
 
 // mock function of makeBucketsWithContext()
-func (mc minioClientMock) makeBucketWithContext(ctx context.Context, bucketName, location string) error {
-	return minioMakeBucketWithContextMock(ctx, bucketName, location)
+func (mc minioClientMock) makeBucketWithContext(ctx context.Context, bucketName, location string, objectLocking bool) error {
+	return minioMakeBucketWithContextMock(ctx, bucketName, location, objectLocking)
 }
 
 // mock function of setBucketPolicyWithContext()
@@ -67,6 +71,16 @@ func (mc minioClientMock) getBucketPolicy(ctx context.Context, bucketName string
 	return minioGetBucketPolicyMock(bucketName)
 }
 
+// mock function of getBucketVersioning()
+func (mc minioClientMock) getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+	return minioGetBucketVersioningMock(ctx, bucketName)
+}
+
+// mock function of setBucketVersioning()
+func (mc minioClientMock) setBucketVersioning(ctx context.Context, bucketName string, config minio.BucketVersioningConfiguration) error {
+	return minioSetBucketVersioningMock(ctx, bucketName, config)
+}
+
 var minioAccountUsageInfoMock func(ctx context.Context) (madmin.AccountUsageInfo, error)
 
 // mock function of dataUsageInfo() needed for list bucket's usage
@@ -116,6 +130,64 @@ func TestListBucket(t *testing.T) {
 	}
 }
 
+func TestSearchSortPaginateBuckets(t *testing.T) {
+	assert := assert.New(t)
+	created := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	newBuckets := func() []madmin.BucketUsageInfo {
+		return []madmin.BucketUsageInfo{
+			{Name: "logs-2020", Size: 300, Created: created.Add(time.Hour)},
+			{Name: "Photos", Size: 100, Created: created},
+			{Name: "logs-2019", Size: 300, Created: created.Add(10 * time.Second)},
+			{Name: "backups", Size: 200, Created: created.Add(time.Minute)},
+		}
+	}
+	names := func(buckets []*models.Bucket) []string {
+		var result []string
+		for _, bucket := range buckets {
+			result = append(result, *bucket.Name)
+		}
+		return result
+	}
+
+	// Test-1: searchBuckets() matches the search ignoring case and the prefix
+	assert.Equal([]string{"Photos"}, names(searchBuckets(bucketsUsageInfoToModel(newBuckets()), "PHOTO", "")))
+	assert.Equal([]string{"logs-2020", "logs-2019"}, names(searchBuckets(bucketsUsageInfoToModel(newBuckets()), "", "logs-")))
+	assert.Equal([]string{"logs-2019"}, names(searchBuckets(bucketsUsageInfoToModel(newBuckets()), "19", "logs-")))
+	assert.Nil(searchBuckets(bucketsUsageInfoToModel(newBuckets()), "", "photos"))
+
+	// Test-2: sortBuckets() sorts by name by default
+	usage := newBuckets()
+	assert.Nil(sortBuckets(usage, "", ""))
+	assert.Equal([]string{"Photos", "backups", "logs-2019", "logs-2020"}, names(bucketsUsageInfoToModel(usage)))
+
+	// Test-3: sortBuckets() sorts by size descending, ties are sorted by name
+	assert.Nil(sortBuckets(usage, "size", "desc"))
+	assert.Equal([]string{"logs-2020", "logs-2019", "backups", "Photos"}, names(bucketsUsageInfoToModel(usage)))
+
+	// Test-4: sortBuckets() sorts by creation date, dates in other time zones are compared as times
+	usage[0].Created = created.Add(-time.Second).In(time.FixedZone("UTC-5", -5*60*60))
+	assert.Nil(sortBuckets(usage, "creation_date", "asc"))
+	assert.Equal([]string{"logs-2020", "Photos", "logs-2019", "backups"}, names(bucketsUsageInfoToModel(usage)))
+
+	// Test-5: sortBuckets() rejects unknown sorts
+	if err := sortBuckets(usage, "owner", ""); assert.Error(err) {
+		assert.Equal("error invalid sort_by owner, it must be name, size or creation_date", err.Error())
+	}
+	assert.Error(sortBuckets(usage, "name", "random"))
+	buckets := bucketsUsageInfoToModel(usage)
+
+	// Test-6: paginateBuckets() returns the requested page
+	page, err := paginateBuckets(buckets, swag.Int32(1), swag.Int32(2))
+	assert.Nil(err)
+	assert.Equal([]string{"Photos", "logs-2019"}, names(page))
+	page, _ = paginateBuckets(buckets, swag.Int32(3), nil)
+	assert.Equal([]string{"backups"}, names(page))
+	page, _ = paginateBuckets(buckets, swag.Int32(10), swag.Int32(2))
+	assert.Equal(0, len(page))
+	_, err = paginateBuckets(buckets, nil, swag.Int32(-1))
+	assert.Error(err)
+}
+
 func TestMakeBucket(t *testing.T) {
 	assert := assert.New(t)
 	// mock minIO client
@@ -124,22 +196,80 @@ func TestMakeBucket(t *testing.T) {
 	ctx := context.Background()
 	// Test-1: makeBucket() create a bucket
 	// mock function response from makeBucketWithContext(ctx)
-	minioMakeBucketWithContextMock = func(ctx context.Context, bucketName, location string) error {
+	minioMakeBucketWithContextMock = func(ctx context.Context, bucketName, location string, objectLocking bool) error {
 		return nil
 	}
-	if err := makeBucket(ctx, minClient, "bucktest1"); err != nil {
+	if err := makeBucket(ctx, minClient, "bucktest1", false); err != nil {
 		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
 	}
 
 	// Test-2 makeBucket() make sure errors are handled correctly when error on MakeBucketWithContext
-	minioMakeBucketWithContextMock = func(ctx context.Context, bucketName, location string) error {
+	minioMakeBucketWithContextMock = func(ctx context.Context, bucketName, location string, objectLocking bool) error {
 		return errors.New("error")
 	}
-	if err := makeBucket(ctx, minClient, "bucktest1"); assert.Error(err) {
+	if err := makeBucket(ctx, minClient, "bucktest1", false); assert.Error(err) {
 		assert.Equal("error", err.Error())
 	}
 }
 
+func TestCreateBucket(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	adminClient := adminClientMock{}
+	function := "createBucket()"
+
+	minioMakeBucketWithContextMock = func(ctx context.Context, bucketName, location string, objectLocking bool) error {
+		return nil
+	}
+	var removed []string
+	minioRemoveBucketMock = func(bucketName string) error {
+		removed = append(removed, bucketName)
+		return nil
+	}
+	minioSetBucketEncryptionMock = func(ctx context.Context, bucketName string, config *sse.Configuration) error {
+		return nil
+	}
+	var quota *madmin.BucketQuota
+	minioSetBucketQuotaMock = func(ctx context.Context, bucketName string, q *madmin.BucketQuota) error {
+		quota = q
+		return nil
+	}
+	req := &models.MakeBucketRequest{
+		Name:       swag.String("bucket1"),
+		Encryption: &models.BucketEncryption{Type: swag.String("sse-s3")},
+		Quota:      &models.BucketQuota{Quota: 1024, Type: "hard"},
+	}
+
+	// Test-1: createBucket() makes the bucket with its settings
+	if err := createBucket(ctx, minClient, adminClient, req); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	if assert.NotNil(quota) {
+		assert.Equal(uint64(1024), quota.Quota)
+	}
+	assert.Nil(removed)
+
+	// Test-2: createBucket() removes the bucket if a setting fails
+	minioSetBucketQuotaMock = func(ctx context.Context, bucketName string, q *madmin.BucketQuota) error {
+		return errors.New("error")
+	}
+	if err := createBucket(ctx, minClient, adminClient, req); assert.Error(err) {
+		assert.Equal("error setting bucket quota: error", err.Error())
+	}
+	assert.Equal([]string{"bucket1"}, removed)
+
+	// Test-3: createBucket() doesn't remove a bucket it couldn't make
+	removed = nil
+	minioMakeBucketWithContextMock = func(ctx context.Context, bucketName, location string, objectLocking bool) error {
+		return errors.New("Your previous request to create the named bucket succeeded and you already own it.")
+	}
+	if err := createBucket(ctx, minClient, adminClient, req); assert.Error(err) {
+		assert.Equal("Your previous request to create the named bucket succeeded and you already own it.", err.Error())
+	}
+	assert.Nil(removed)
+}
+
 func TestDeleteBucket(t *testing.T) {
 	assert := assert.New(t)
 	// mock minIO client
@@ -170,6 +300,18 @@ func TestBucketInfo(t *testing.T) {
 	// mock minIO client
 	minClient := minioClientMock{}
 	function := "getBucketInfo()"
+	minioGetBucketVersioningMock = func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+		return minio.BucketVersioningConfiguration{}, nil
+	}
+	minioGetBucketEncryptionMock = func(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+		return nil, minio.ErrorResponse{Code: "ServerSideEncryptionConfigurationNotFoundError"}
+	}
+	minioGetObjectLockConfigMock = func(ctx context.Context, bucketName string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+		return "", nil, nil, nil, minio.ErrorResponse{Code: "ObjectLockConfigurationNotFoundError"}
+	}
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchTagSet"}
+	}
 
 	// Test-1: getBucketInfo() get a bucket with PRIVATE access
 	// if not policy set on bucket, access should be PRIVATE
@@ -270,6 +412,117 @@ func TestBucketInfo(t *testing.T) {
 	if assert.Error(err) {
 		assert.Equal("error", err.Error())
 	}
+
+	// Test-5: getBucketInfo() returns the versioning status
+	minioGetBucketPolicyMock = func(bucketName string) (string, error) {
+		return "", nil
+	}
+	minioGetBucketVersioningMock = func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+		return minio.BucketVersioningConfiguration{Status: "Enabled"}, nil
+	}
+	bucketInfo, err = getBucketInfo(minClient, bucketToSet)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("Enabled", bucketInfo.Versioning)
+
+	// Test-6: getBucketInfo() ignores versioning not being implemented by the server
+	minioGetBucketVersioningMock = func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+		return minio.BucketVersioningConfiguration{}, minio.ErrorResponse{Code: "NotImplemented"}
+	}
+	bucketInfo, err = getBucketInfo(minClient, bucketToSet)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("", bucketInfo.Versioning)
+	assert.Nil(bucketInfo.Encryption)
+	assert.False(bucketInfo.ObjectLocking)
+
+	// Test-7: getBucketInfo() returns the default encryption and retention
+	minioGetBucketEncryptionMock = func(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+		return sse.NewConfigurationSSEKMS("my-key"), nil
+	}
+	minioGetObjectLockConfigMock = func(ctx context.Context, bucketName string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+		mode := minio.Compliance
+		validity := uint(30)
+		unit := minio.Days
+		return "Enabled", &mode, &validity, &unit, nil
+	}
+	bucketInfo, err = getBucketInfo(minClient, bucketToSet)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(&models.BucketEncryption{Type: swag.String("sse-kms"), KmsKeyID: "my-key"}, bucketInfo.Encryption)
+	assert.True(bucketInfo.ObjectLocking)
+	assert.Equal(&models.BucketRetention{Mode: swag.String("compliance"), Validity: swag.Int32(30), Unit: "days"}, bucketInfo.Retention)
+	assert.Equal(map[string]string{}, bucketInfo.Tags)
+
+	// Test-8: getBucketInfo() returns the bucket tags
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return tags.MapToBucketTags(map[string]string{"team": "analytics"})
+	}
+	bucketInfo, err = getBucketInfo(minClient, bucketToSet)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(map[string]string{"team": "analytics"}, bucketInfo.Tags)
+
+	// Test-9: getBucketInfo() leaves empty the settings the user can't read
+	accessDenied := minio.ErrorResponse{Code: "AccessDenied"}
+	minioGetBucketVersioningMock = func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+		return minio.BucketVersioningConfiguration{}, accessDenied
+	}
+	minioGetBucketEncryptionMock = func(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+		return nil, accessDenied
+	}
+	minioGetObjectLockConfigMock = func(ctx context.Context, bucketName string) (string, *minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+		return "", nil, nil, nil, accessDenied
+	}
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return nil, accessDenied
+	}
+	bucketInfo, err = getBucketInfo(minClient, bucketToSet)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(models.BucketAccessPRIVATE, bucketInfo.Access)
+	assert.Equal("", bucketInfo.Versioning)
+	assert.Nil(bucketInfo.Encryption)
+	assert.False(bucketInfo.ObjectLocking)
+	assert.Nil(bucketInfo.Retention)
+	assert.Nil(bucketInfo.Tags)
+}
+
+func TestSetBucketVersioning(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	// mock minIO client
+	minClient := minioClientMock{}
+	function := "setBucketVersioning()"
+
+	// Test-1: setBucketVersioning() suspends versioning
+	var status string
+	minioSetBucketVersioningMock = func(ctx context.Context, bucketName string, config minio.BucketVersioningConfiguration) error {
+		status = config.Status
+		return nil
+	}
+	if err := setBucketVersioning(ctx, minClient, "bucket1", "Suspended"); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("Suspended", status)
+
+	// Test-2: setBucketVersioning() rejects unknown statuses
+	if err := setBucketVersioning(ctx, minClient, "bucket1", "Disabled"); assert.Error(err) {
+		assert.Equal("error versioning status Disabled not supported", err.Error())
+	}
+
+	// Test-3: setBucketVersioning() handles errors correctly
+	minioSetBucketVersioningMock = func(ctx context.Context, bucketName string, config minio.BucketVersioningConfiguration) error {
+		return errors.New("error")
+	}
+	if err := setBucketVersioning(ctx, minClient, "bucket1", "Enabled"); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
 }
 
 func TestSetBucketAccess(t *testing.T) {
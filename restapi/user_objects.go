@@ -0,0 +1,501 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// defaultObjectsDelimiter groups keys into "folders" when listing non recursively
+	defaultObjectsDelimiter = "/"
+	// defaultObjectsMaxKeys is the page size used when max_keys is not present on the request
+	defaultObjectsMaxKeys = 1000
+	// uploadMetadataField is the multipart field holding the user metadata applied to the files that follow it
+	uploadMetadataField = "metadata"
+	// maxUploadMetadataSize limits how much of the metadata field is read
+	maxUploadMetadataSize = 8 * 1024
+	// uploadPartSize is the buffer used per file when streaming it to MinIO since its size is unknown beforehand
+	uploadPartSize = 16 * 1024 * 1024
+	// userMetadataHeaderPrefix identifies the part headers that are stored as user metadata
+	userMetadataHeaderPrefix = "X-Amz-Meta-"
+)
+
+func registerObjectsHandlers(api *operations.ConsoleAPI) {
+	// list objects
+	api.UserAPIListObjectsHandler = user_api.ListObjectsHandlerFunc(func(params user_api.ListObjectsParams, session *models.Principal) middleware.Responder {
+		listObjectsResponse, err := getListObjectsResponse(session, params)
+		if err != nil {
+			return user_api.NewListObjectsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewListObjectsOK().WithPayload(listObjectsResponse)
+	})
+	// upload objects
+	api.UserAPIUploadObjectsHandler = user_api.UploadObjectsHandlerFunc(func(params user_api.UploadObjectsParams, session *models.Principal) middleware.Responder {
+		uploadObjectsResponse, err := getUploadObjectsResponse(session, params)
+		if err != nil {
+			return user_api.NewUploadObjectsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewUploadObjectsOK().WithPayload(uploadObjectsResponse)
+	})
+	// create folder
+	api.UserAPICreateFolderHandler = user_api.CreateFolderHandlerFunc(func(params user_api.CreateFolderParams, session *models.Principal) middleware.Responder {
+		if err := getCreateFolderResponse(session, params); err != nil {
+			return user_api.NewCreateFolderDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewCreateFolderCreated()
+	})
+	// download object
+	api.UserAPIDownloadObjectHandler = user_api.DownloadObjectHandlerFunc(func(params user_api.DownloadObjectParams, session *models.Principal) middleware.Responder {
+		object, info, err := getDownloadObjectResponse(session, params)
+		if err != nil {
+			return user_api.NewDownloadObjectDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		// Custom response writer so range and conditional requests are answered
+		// with the right status code and headers
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			serveObject(w, params.HTTPRequest, object, info)
+			if err := object.Close(); err != nil {
+				log.Println(err)
+			}
+		})
+	})
+	// download objects under a prefix as a zip archive
+	api.UserAPIDownloadObjectsZipHandler = user_api.DownloadObjectsZipHandlerFunc(func(params user_api.DownloadObjectsZipParams, session *models.Principal) middleware.Responder {
+		mClient, err := newMinioClient(session)
+		if err != nil {
+			return user_api.NewDownloadObjectsZipDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		// create a minioClient interface implementation
+		// defining the client to be used
+		minioClient := minioClient{client: mClient}
+		prefix := ""
+		if params.Prefix != nil {
+			prefix = *params.Prefix
+		}
+		// Custom response writer, the archive is built while it's being sent so
+		// errors found after this point can only be logged
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			w.Header().Set("Content-Type", "application/zip")
+			w.Header().Set("Content-Disposition", attachmentDisposition(zipArchiveName(params.BucketName, prefix)))
+			if err := writeObjectsZip(params.HTTPRequest.Context(), minioClient, params.BucketName, prefix, w); err != nil {
+				log.Println("error writing zip archive:", err)
+			}
+		})
+	})
+}
+
+// listObjectsOptions holds the already defaulted parameters used to list a single page of objects
+type listObjectsOptions struct {
+	prefix            string
+	delimiter         string
+	continuationToken string
+	maxKeys           int
+}
+
+// getListObjectsOptions reads the listing parameters from the request and applies the defaults,
+// a recursive listing ignores the delimiter so every key under the prefix is returned.
+func getListObjectsOptions(params user_api.ListObjectsParams) listObjectsOptions {
+	opts := listObjectsOptions{
+		delimiter: defaultObjectsDelimiter,
+		maxKeys:   defaultObjectsMaxKeys,
+	}
+	if params.Prefix != nil {
+		opts.prefix = *params.Prefix
+	}
+	if params.Delimiter != nil {
+		opts.delimiter = *params.Delimiter
+	}
+	if params.Recursive != nil && *params.Recursive {
+		opts.delimiter = ""
+	}
+	if params.ContinuationToken != nil {
+		opts.continuationToken = *params.ContinuationToken
+	}
+	if params.MaxKeys != nil && *params.MaxKeys > 0 && *params.MaxKeys < defaultObjectsMaxKeys {
+		opts.maxKeys = int(*params.MaxKeys)
+	}
+	return opts
+}
+
+// listBucketObjects fetches a single page of objects and common prefixes of a bucket
+func listBucketObjects(ctx context.Context, client MinioClient, bucketName string, opts listObjectsOptions) (*models.ListObjectsResponse, error) {
+	result, err := client.listObjectsV2(ctx, bucketName, opts.prefix, opts.continuationToken, opts.delimiter, opts.maxKeys)
+	if err != nil {
+		return nil, err
+	}
+	var objects []*models.BucketObject
+	// common prefixes are returned first so folders are listed before files
+	for _, commonPrefix := range result.CommonPrefixes {
+		objects = append(objects, &models.BucketObject{
+			Name:     commonPrefix.Prefix,
+			IsPrefix: true,
+		})
+	}
+	for _, object := range result.Contents {
+		objects = append(objects, &models.BucketObject{
+			Name:         object.Key,
+			Size:         object.Size,
+			ContentType:  object.ContentType,
+			LastModified: object.LastModified.Format(time.RFC3339),
+			Etag:         object.ETag,
+			StorageClass: object.StorageClass,
+		})
+	}
+	listObjectsResponse := &models.ListObjectsResponse{
+		Objects:     objects,
+		Total:       int64(len(objects)),
+		IsTruncated: result.IsTruncated,
+	}
+	if result.IsTruncated {
+		listObjectsResponse.NextContinuationToken = result.NextContinuationToken
+	}
+	return listObjectsResponse, nil
+}
+
+// getListObjectsResponse performs listBucketObjects() and serializes it to the handler's output
+func getListObjectsResponse(session *models.Principal, params user_api.ListObjectsParams) (*models.ListObjectsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	objects, err := listBucketObjects(ctx, minioClient, params.BucketName, getListObjectsOptions(params))
+	if err != nil {
+		log.Println("error listing objects:", err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+// folderObjectName validates a folder path and returns the name of its placeholder object,
+// the path must be relative and can't have empty, "." or ".." segments.
+func folderObjectName(folderPath string) (string, error) {
+	folderPath = strings.TrimSuffix(folderPath, "/")
+	if folderPath == "" {
+		return "", errors.New(500, "error folder path is empty")
+	}
+	if strings.HasPrefix(folderPath, "/") {
+		return "", errors.New(500, "error folder path can't start with a slash")
+	}
+	for _, segment := range strings.Split(folderPath, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", errors.New(500, "error invalid folder path %s", folderPath)
+		}
+	}
+	return folderPath + "/", nil
+}
+
+// createFolder puts an empty object named after the folder with a trailing slash, so the prefix
+// is listed as a folder even before any object is uploaded into it.
+func createFolder(ctx context.Context, client MinioClient, bucketName, folderPath string) error {
+	objectName, err := folderObjectName(folderPath)
+	if err != nil {
+		return err
+	}
+	_, err = client.putObject(ctx, bucketName, objectName, strings.NewReader(""), 0, minio.PutObjectOptions{})
+	return err
+}
+
+// getCreateFolderResponse performs createFolder()
+func getCreateFolderResponse(session *models.Principal, params user_api.CreateFolderParams) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := createFolder(ctx, minioClient, params.BucketName, *params.Body.Path); err != nil {
+		log.Println("error creating folder:", err)
+		return err
+	}
+	return nil
+}
+
+// uploadObjects streams every file of a multipart body into the bucket, parts are read one at a time
+// so whole files are never held in memory. A failed file is reported on its own entry and doesn't
+// stop the rest of the upload.
+func uploadObjects(ctx context.Context, client MinioClient, bucketName, prefix string, reader *multipart.Reader) (*models.UploadObjectsResponse, error) {
+	uploadResponse := &models.UploadObjectsResponse{}
+	metadata := map[string]string{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// regular form fields carry the options for the files that follow
+		if part.FileName() == "" {
+			if part.FormName() == uploadMetadataField {
+				metadata = map[string]string{}
+				if err := json.NewDecoder(io.LimitReader(part, maxUploadMetadataSize)).Decode(&metadata); err != nil {
+					return nil, fmt.Errorf("invalid %s field: %v", uploadMetadataField, err)
+				}
+			}
+			continue
+		}
+		object := uploadObjectPart(ctx, client, bucketName, prefix, part, metadata)
+		if object.Error != "" {
+			uploadResponse.Failed++
+		}
+		uploadResponse.Objects = append(uploadResponse.Objects, object)
+	}
+	uploadResponse.Total = int64(len(uploadResponse.Objects))
+	return uploadResponse, nil
+}
+
+// uploadObjectPart puts a single file part, the content type sent by the caller is kept and if missing
+// it is guessed from the file extension. User metadata can be set per file with X-Amz-Meta-* part headers.
+func uploadObjectPart(ctx context.Context, client MinioClient, bucketName, prefix string, part *multipart.Part, metadata map[string]string) *models.UploadedObject {
+	objectName := path.Join(prefix, part.FileName())
+	contentType := part.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(objectName))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	userMetadata := make(map[string]string)
+	for k, v := range metadata {
+		userMetadata[k] = v
+	}
+	for k := range part.Header {
+		if strings.HasPrefix(k, userMetadataHeaderPrefix) {
+			userMetadata[strings.TrimPrefix(k, userMetadataHeaderPrefix)] = part.Header.Get(k)
+		}
+	}
+	object := &models.UploadedObject{
+		Name:        objectName,
+		ContentType: contentType,
+	}
+	info, err := client.putObject(ctx, bucketName, objectName, part, -1, minio.PutObjectOptions{
+		ContentType:  contentType,
+		UserMetadata: userMetadata,
+		PartSize:     uploadPartSize,
+	})
+	if err != nil {
+		log.Println("error uploading object:", err)
+		object.Error = err.Error()
+		return object
+	}
+	object.Size = info.Size
+	object.Etag = info.ETag
+	object.VersionID = info.VersionID
+	return object
+}
+
+// getUploadObjectsResponse performs uploadObjects() using the request's multipart body
+func getUploadObjectsResponse(session *models.Principal, params user_api.UploadObjectsParams) (*models.UploadObjectsResponse, error) {
+	// uploads are bound to the request lifetime instead of a fixed timeout since files can be large
+	ctx := params.HTTPRequest.Context()
+	reader, err := params.HTTPRequest.MultipartReader()
+	if err != nil {
+		log.Println("error reading multipart body:", err)
+		return nil, err
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	prefix := ""
+	if params.Prefix != nil {
+		prefix = *params.Prefix
+	}
+	uploadResponse, err := uploadObjects(ctx, minioClient, params.BucketName, prefix, reader)
+	if err != nil {
+		log.Println("error uploading objects:", err)
+		return nil, err
+	}
+	return uploadResponse, nil
+}
+
+// openObject gets an object, or one of its versions when versionID is set, along with its information.
+// The stat is done upfront so a missing object or a denied access is reported before anything is
+// written to the client.
+func openObject(ctx context.Context, client MinioClient, bucketName, objectName, versionID string) (objectReader, minio.ObjectInfo, error) {
+	object, err := client.getObject(ctx, bucketName, objectName, minio.GetObjectOptions{VersionID: versionID})
+	if err != nil {
+		return nil, minio.ObjectInfo{}, err
+	}
+	info, err := object.Stat()
+	if err != nil {
+		object.Close()
+		return nil, minio.ObjectInfo{}, err
+	}
+	return object, info, nil
+}
+
+// serveObject writes the object to the client passing through its content type. Since the object
+// is seekable http.ServeContent takes care of Range, If-None-Match and If-Modified-Since requests.
+func serveObject(w http.ResponseWriter, r *http.Request, object io.ReadSeeker, info minio.ObjectInfo) {
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", attachmentDisposition(path.Base(info.Key)))
+	if info.ETag != "" {
+		w.Header().Set("ETag", "\""+info.ETag+"\"")
+	}
+	http.ServeContent(w, r, "", info.LastModified, object)
+}
+
+// attachmentDisposition returns the Content-Disposition value telling the client to save the response as fileName
+func attachmentDisposition(fileName string) string {
+	if disposition := mime.FormatMediaType("attachment", map[string]string{"filename": fileName}); disposition != "" {
+		return disposition
+	}
+	return "attachment"
+}
+
+// getDownloadObjectResponse opens the requested object to be streamed back to the client
+func getDownloadObjectResponse(session *models.Principal, params user_api.DownloadObjectParams) (objectReader, minio.ObjectInfo, error) {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return nil, minio.ObjectInfo{}, errors.New(500, "error object name not in request")
+	}
+	// the object is read while the response is written so the request context is used
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, minio.ObjectInfo{}, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	versionID := ""
+	if params.VersionID != nil {
+		versionID = *params.VersionID
+	}
+	object, info, err := openObject(ctx, minioClient, params.BucketName, *params.Prefix, versionID)
+	if err != nil {
+		log.Println("error getting object:", err)
+		return nil, minio.ObjectInfo{}, err
+	}
+	return object, info, nil
+}
+
+// zipArchiveName names the archive after the last folder of the prefix or after the bucket
+// when the whole bucket is downloaded
+func zipArchiveName(bucketName, prefix string) string {
+	name := path.Base(strings.TrimSuffix(prefix, "/"))
+	if name == "." || name == "/" {
+		name = bucketName
+	}
+	return name + ".zip"
+}
+
+// writeObjectsZip streams a zip archive with every object under prefix into w, each object
+// is copied straight from MinIO into the archive so nothing is buffered on disk or memory.
+// Entries are named relative to the parent of the prefix so the archive contains the folder itself.
+// A prefix without a trailing slash names a single object or a folder, never its siblings such as
+// photos-old/ or photos.txt for photos.
+func writeObjectsZip(ctx context.Context, client MinioClient, bucketName, prefix string, w io.Writer) error {
+	parent := ""
+	if i := strings.LastIndex(strings.TrimSuffix(prefix, "/"), "/"); i >= 0 {
+		parent = prefix[:i+1]
+	}
+	archive := zip.NewWriter(w)
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		info, err := client.statObject(ctx, bucketName, prefix, minio.StatObjectOptions{})
+		if err == nil {
+			info.Key = prefix
+			if err := addObjectToZip(ctx, client, archive, bucketName, info, strings.TrimPrefix(prefix, parent)); err != nil {
+				return err
+			}
+			return archive.Close()
+		}
+		if minio.ToErrorResponse(err).Code != "NoSuchKey" {
+			return err
+		}
+		prefix += "/"
+	}
+	for info := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if info.Err != nil {
+			return info.Err
+		}
+		// folder placeholders don't have content
+		if strings.HasSuffix(info.Key, "/") {
+			continue
+		}
+		if err := addObjectToZip(ctx, client, archive, bucketName, info, strings.TrimPrefix(info.Key, parent)); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// addObjectToZip copies a single object into a new entry of the archive
+func addObjectToZip(ctx context.Context, client MinioClient, archive *zip.Writer, bucketName string, info minio.ObjectInfo, entryName string) error {
+	object, err := client.getObject(ctx, bucketName, info.Key, minio.GetObjectOptions{})
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+	entry, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     entryName,
+		Method:   zip.Deflate,
+		Modified: info.LastModified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, object)
+	return err
+}
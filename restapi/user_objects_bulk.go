@@ -0,0 +1,291 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+const (
+	// maxBulkManifestEntries limits the number of objects of a single manifest
+	maxBulkManifestEntries = 10000
+	// defaultBulkConcurrency is the number of objects processed at the same time if not requested
+	defaultBulkConcurrency = 8
+	// maxBulkConcurrency limits the requested concurrency
+	maxBulkConcurrency = 32
+	// bulkObjectsTimeout bounds the whole bulk operation
+	bulkObjectsTimeout = 10 * time.Minute
+)
+
+// bulkManifestEntry is an object of the manifest along with its position
+type bulkManifestEntry struct {
+	line      int64
+	key       string
+	versionID string
+}
+
+func registerObjectsBulkHandlers(api *operations.ConsoleAPI) {
+	// apply an action to the objects of a manifest
+	api.UserAPIBulkObjectsHandler = user_api.BulkObjectsHandlerFunc(func(params user_api.BulkObjectsParams, session *models.Principal) middleware.Responder {
+		bulkResponse, err := getBulkObjectsResponse(session, params)
+		if err != nil {
+			return user_api.NewBulkObjectsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewBulkObjectsOK().WithPayload(bulkResponse)
+	})
+}
+
+// parseBulkManifest reads the objects of a CSV or JSON manifest, the format is detected from the
+// content if not given. CSV lines have a key and an optional version id, the first line is only
+// skipped when header is set since any line could be an object. JSON manifests are an array of
+// {key, version_id}.
+func parseBulkManifest(manifest, format string, header bool) ([]bulkManifestEntry, error) {
+	if format == "" {
+		format = models.BulkObjectsRequestManifestFormatCsv
+		if strings.HasPrefix(strings.TrimSpace(manifest), "[") {
+			format = models.BulkObjectsRequestManifestFormatJSON
+		}
+	}
+	var entries []bulkManifestEntry
+	switch format {
+	case models.BulkObjectsRequestManifestFormatJSON:
+		var objects []struct {
+			Key       string `json:"key"`
+			VersionID string `json:"version_id"`
+		}
+		if err := json.Unmarshal([]byte(manifest), &objects); err != nil {
+			return nil, errors.New(500, "error invalid JSON manifest: %v", err)
+		}
+		for i, object := range objects {
+			entries = append(entries, bulkManifestEntry{line: int64(i + 1), key: object.Key, versionID: object.VersionID})
+		}
+	case models.BulkObjectsRequestManifestFormatCsv:
+		reader := csv.NewReader(strings.NewReader(manifest))
+		reader.FieldsPerRecord = -1
+		for line := int64(1); ; line++ {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, errors.New(500, "error invalid CSV manifest: %v", err)
+			}
+			if line == 1 && header {
+				continue
+			}
+			entry := bulkManifestEntry{line: line, key: record[0]}
+			if len(record) > 1 {
+				entry.versionID = strings.TrimSpace(record[1])
+			}
+			entries = append(entries, entry)
+		}
+	default:
+		return nil, errors.New(500, "error manifest format %s not supported", format)
+	}
+	if len(entries) == 0 {
+		return nil, errors.New(500, "error manifest has no objects")
+	}
+	if len(entries) > maxBulkManifestEntries {
+		return nil, errors.New(500, "error manifest has more than %d objects", maxBulkManifestEntries)
+	}
+	return entries, nil
+}
+
+// validateBulkObjectsRequest checks the action has every option it needs
+func validateBulkObjectsRequest(bucketName string, req *models.BulkObjectsRequest) error {
+	switch *req.Action {
+	case models.BulkObjectsRequestActionCopy:
+		if req.DestinationBucket == "" {
+			return errors.New(500, "error destination_bucket is required to copy objects")
+		}
+		if req.DestinationBucket == bucketName {
+			return errors.New(500, "error objects can't be copied to the same bucket")
+		}
+	case models.BulkObjectsRequestActionSetTags:
+		if len(req.Tags) > 0 {
+			if _, err := tags.MapToObjectTags(req.Tags); err != nil {
+				return err
+			}
+		}
+	case models.BulkObjectsRequestActionSetLegalHold:
+		if req.LegalHold == "" {
+			return errors.New(500, "error legal_hold is required to set the legal hold")
+		}
+	}
+	if req.Concurrency < 0 || req.Concurrency > maxBulkConcurrency {
+		return errors.New(500, "error concurrency must be between 1 and %d, or 0 to use the default", maxBulkConcurrency)
+	}
+	return nil
+}
+
+// bulkObjectAction applies the requested action to a single object
+func bulkObjectAction(ctx context.Context, client MinioClient, bucketName string, req *models.BulkObjectsRequest, entry bulkManifestEntry) error {
+	switch *req.Action {
+	case models.BulkObjectsRequestActionCopy:
+		info, err := client.statObject(ctx, bucketName, entry.key, minio.StatObjectOptions{VersionID: entry.versionID})
+		if err != nil {
+			return err
+		}
+		src := minio.CopySrcOptions{Bucket: bucketName, Object: entry.key, VersionID: entry.versionID}
+		dst := minio.CopyDestOptions{Bucket: req.DestinationBucket, Object: entry.key}
+		_, err = copyObjectServerSide(ctx, client, src, dst, info.Size)
+		return err
+	case models.BulkObjectsRequestActionSetTags:
+		_, err := putObjectTags(ctx, client, bucketName, entry.key, entry.versionID, req.Tags)
+		return err
+	case models.BulkObjectsRequestActionSetLegalHold:
+		return setObjectLegalHold(ctx, client, bucketName, entry.key, entry.versionID, req.LegalHold)
+	}
+	return errors.New(500, "error action %s not supported", *req.Action)
+}
+
+// bulkObjects applies the action to every object of the manifest using up to concurrency workers.
+// Deletes are sent in batches through the multi-object delete API, the rest of the actions are
+// applied one object at a time. A failed object doesn't stop the rest and results keep the
+// manifest order.
+func bulkObjects(ctx context.Context, client MinioClient, bucketName string, req *models.BulkObjectsRequest) (*models.BulkObjectsResponse, error) {
+	if err := validateBulkObjectsRequest(bucketName, req); err != nil {
+		return nil, err
+	}
+	entries, err := parseBulkManifest(*req.Manifest, req.ManifestFormat, req.ManifestHeader)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*models.BulkObjectResult, len(entries))
+	for i, entry := range entries {
+		results[i] = &models.BulkObjectResult{Line: entry.line, Key: entry.key, VersionID: entry.versionID}
+		if entry.key == "" {
+			results[i].Error = "key is required"
+		}
+	}
+
+	// every job is a group of entries, objects without key are already failed
+	batchSize := 1
+	if *req.Action == models.BulkObjectsRequestActionDelete {
+		batchSize = removeObjectsBatchSize
+	}
+	var jobs [][]int
+	var batch []int
+	for i := range entries {
+		if results[i].Error != "" {
+			continue
+		}
+		batch = append(batch, i)
+		if len(batch) == batchSize {
+			jobs = append(jobs, batch)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		jobs = append(jobs, batch)
+	}
+
+	concurrency := int(req.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultBulkConcurrency
+	}
+	jobsCh := make(chan []int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// each entry belongs to a single job so results are written without locking
+			for job := range jobsCh {
+				if *req.Action == models.BulkObjectsRequestActionDelete {
+					objects := make([]minio.ObjectInfo, len(job))
+					for j, i := range job {
+						objects[j] = minio.ObjectInfo{Key: entries[i].key, VersionID: entries[i].versionID}
+					}
+					removed, err := removeObjectsBatch(ctx, client, bucketName, objects)
+					for j, i := range job {
+						if err != nil {
+							results[i].Error = err.Error()
+							continue
+						}
+						results[i].Error = removed[j].Error
+					}
+					continue
+				}
+				for _, i := range job {
+					if err := bulkObjectAction(ctx, client, bucketName, req, entries[i]); err != nil {
+						results[i].Error = err.Error()
+					}
+				}
+			}
+		}()
+	}
+	for _, job := range jobs {
+		jobsCh <- job
+	}
+	close(jobsCh)
+	wg.Wait()
+
+	bulkResponse := &models.BulkObjectsResponse{
+		Action:  *req.Action,
+		Total:   int64(len(results)),
+		Results: results,
+	}
+	for _, result := range results {
+		if result.Error != "" {
+			result.Status = models.BulkObjectResultStatusFailed
+			bulkResponse.Failed++
+			continue
+		}
+		result.Status = models.BulkObjectResultStatusSuccess
+		bulkResponse.Succeeded++
+	}
+	return bulkResponse, nil
+}
+
+// getBulkObjectsResponse performs bulkObjects() and serializes it to the handler's output
+func getBulkObjectsResponse(session *models.Principal, params user_api.BulkObjectsParams) (*models.BulkObjectsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), bulkObjectsTimeout)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	bulkResponse, err := bulkObjects(ctx, minioClient, params.BucketName, params.Body)
+	if err != nil {
+		log.Println("error applying bulk action:", err)
+		return nil, err
+	}
+	return bulkResponse, nil
+}
@@ -0,0 +1,178 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBulkManifest(t *testing.T) {
+	assert := assert.New(t)
+	function := "parseBulkManifest()"
+
+	// Test-1: parseBulkManifest() reads CSV manifests skipping the header
+	entries, err := parseBulkManifest("key,version_id\nphotos/a.jpg,v1\n\"photos/b,c.jpg\"\n", "", true)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]bulkManifestEntry{
+		{line: 2, key: "photos/a.jpg", versionID: "v1"},
+		{line: 3, key: "photos/b,c.jpg"},
+	}, entries)
+
+	// Test-2: parseBulkManifest() keeps the first line without header, even an object named key
+	entries, err = parseBulkManifest("key\nphotos/a.jpg\n", "csv", false)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]bulkManifestEntry{
+		{line: 1, key: "key"},
+		{line: 2, key: "photos/a.jpg"},
+	}, entries)
+
+	// Test-3: parseBulkManifest() detects JSON manifests
+	entries, err = parseBulkManifest(` [{"key":"a.txt"},{"key":"b.txt","version_id":"v2"}]`, "", false)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]bulkManifestEntry{
+		{line: 1, key: "a.txt"},
+		{line: 2, key: "b.txt", versionID: "v2"},
+	}, entries)
+
+	// Test-4: parseBulkManifest() rejects invalid and empty manifests
+	_, err = parseBulkManifest("{}", "json", false)
+	assert.Error(err)
+	if _, err = parseBulkManifest("key\n", "csv", true); assert.Error(err) {
+		assert.Equal("error manifest has no objects", err.Error())
+	}
+}
+
+func TestBulkObjects(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "bulkObjects()"
+
+	// Test-1: bulkObjects() deletes objects in batches reporting every entry
+	var removed []minio.ObjectInfo
+	minioRemoveObjectsMock = func(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+		errCh := make(chan minio.RemoveObjectError, removeObjectsBatchSize)
+		for object := range objectsCh {
+			if object.Key == "locked.txt" {
+				errCh <- minio.RemoveObjectError{ObjectName: object.Key, Err: errors.New("Access Denied.")}
+				continue
+			}
+			removed = append(removed, object)
+		}
+		close(errCh)
+		return errCh
+	}
+	req := &models.BulkObjectsRequest{
+		Action:   swag.String(models.BulkObjectsRequestActionDelete),
+		Manifest: swag.String("a.txt,v1\nlocked.txt\n,v3\nb.txt\n"),
+	}
+	bulkResponse, err := bulkObjects(ctx, minClient, "bucket1", req)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]minio.ObjectInfo{{Key: "a.txt", VersionID: "v1"}, {Key: "b.txt"}}, removed)
+	assert.Equal(int64(4), bulkResponse.Total)
+	assert.Equal(int64(2), bulkResponse.Succeeded)
+	assert.Equal(int64(2), bulkResponse.Failed)
+	if assert.Equal(4, len(bulkResponse.Results)) {
+		assert.Equal(&models.BulkObjectResult{Line: 1, Key: "a.txt", VersionID: "v1", Status: models.BulkObjectResultStatusSuccess}, bulkResponse.Results[0])
+		assert.Equal("Access Denied.", bulkResponse.Results[1].Error)
+		assert.Equal("key is required", bulkResponse.Results[2].Error)
+		assert.Equal(models.BulkObjectResultStatusSuccess, bulkResponse.Results[3].Status)
+	}
+
+	// Test-2: bulkObjects() sets the legal hold of every object concurrently
+	var lock sync.Mutex
+	held := map[string]minio.LegalHoldStatus{}
+	minioPutObjectLegalHoldMock = func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectLegalHoldOptions) error {
+		if objectName == "missing.txt" {
+			return errors.New("The specified key does not exist.")
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		held[objectName+opts.VersionID] = *opts.Status
+		return nil
+	}
+	req = &models.BulkObjectsRequest{
+		Action:      swag.String(models.BulkObjectsRequestActionSetLegalHold),
+		Manifest:    swag.String(`[{"key":"a.txt","version_id":"v1"},{"key":"missing.txt"},{"key":"c.txt"}]`),
+		LegalHold:   models.BulkObjectsRequestLegalHoldEnabled,
+		Concurrency: 2,
+	}
+	bulkResponse, err = bulkObjects(ctx, minClient, "bucket1", req)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(map[string]minio.LegalHoldStatus{"a.txtv1": minio.LegalHoldEnabled, "c.txt": minio.LegalHoldEnabled}, held)
+	assert.Equal(int64(1), bulkResponse.Failed)
+	assert.Equal("The specified key does not exist.", bulkResponse.Results[1].Error)
+	assert.Equal(models.BulkObjectResultStatusSuccess, bulkResponse.Results[2].Status)
+
+	// Test-3: bulkObjects() copies objects keeping their names
+	var copied []string
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{Key: objectName, Size: 10}, nil
+	}
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		copied = append(copied, src.Bucket+"/"+src.Object+"@"+src.VersionID+" -> "+dst.Bucket+"/"+dst.Object)
+		return minio.UploadInfo{}, nil
+	}
+	req = &models.BulkObjectsRequest{
+		Action:            swag.String(models.BulkObjectsRequestActionCopy),
+		Manifest:          swag.String("photos/a.jpg,v1"),
+		DestinationBucket: "archive",
+	}
+	bulkResponse, err = bulkObjects(ctx, minClient, "bucket1", req)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]string{"bucket1/photos/a.jpg@v1 -> archive/photos/a.jpg"}, copied)
+	assert.Equal(int64(1), bulkResponse.Succeeded)
+
+	// Test-4: bulkObjects() validates the options of the action
+	req.DestinationBucket = "bucket1"
+	if _, err = bulkObjects(ctx, minClient, "bucket1", req); assert.Error(err) {
+		assert.Equal("error objects can't be copied to the same bucket", err.Error())
+	}
+	req = &models.BulkObjectsRequest{
+		Action:   swag.String(models.BulkObjectsRequestActionSetTags),
+		Manifest: swag.String("a.txt"),
+		Tags:     map[string]string{"": "value"},
+	}
+	_, err = bulkObjects(ctx, minClient, "bucket1", req)
+	assert.Error(err)
+}
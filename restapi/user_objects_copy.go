@@ -0,0 +1,357 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// maxCopyObjectSize is the largest object accepted by a single CopyObject call,
+	// bigger objects are copied in parts through ComposeObject.
+	maxCopyObjectSize = 5 * 1024 * 1024 * 1024
+	// maxCopyOperationErrors limits the errors kept on each operation
+	maxCopyOperationErrors = 100
+	// copyOperationsRetention is how long finished operations are kept in memory
+	copyOperationsRetention = time.Hour
+)
+
+// copyOperation tracks the progress of a copy or move running in the background
+type copyOperation struct {
+	sync.Mutex
+	createdBy  string
+	finishedAt time.Time
+	status     models.CopyOperation
+}
+
+// update applies a change to the operation status
+func (op *copyOperation) update(change func(status *models.CopyOperation)) {
+	op.Lock()
+	defer op.Unlock()
+	change(&op.status)
+}
+
+// addError records a failure, only the first maxCopyOperationErrors messages are kept
+func (op *copyOperation) addError(msg string) {
+	op.update(func(status *models.CopyOperation) {
+		status.Failed++
+		if len(status.Errors) < maxCopyOperationErrors {
+			status.Errors = append(status.Errors, msg)
+		}
+	})
+}
+
+// finish sets the final status of the operation
+func (op *copyOperation) finish(now time.Time, result string) {
+	op.Lock()
+	defer op.Unlock()
+	op.finishedAt = now
+	op.status.Status = result
+	op.status.FinishedAt = now.Format(time.RFC3339)
+}
+
+// snapshot returns a copy of the operation status safe to be serialized
+func (op *copyOperation) snapshot() *models.CopyOperation {
+	op.Lock()
+	defer op.Unlock()
+	status := op.status
+	status.Errors = append([]string{}, op.status.Errors...)
+	return &status
+}
+
+// copyOperationsRegistry keeps in memory the operations started since the console started
+type copyOperationsRegistry struct {
+	sync.Mutex
+	operations map[string]*copyOperation
+}
+
+// globalCopyOperations records every operation started by CopyObjects
+var globalCopyOperations = &copyOperationsRegistry{}
+
+// add records a new operation and drops the ones finished more than copyOperationsRetention ago
+func (r *copyOperationsRegistry) add(op *copyOperation, now time.Time) {
+	r.Lock()
+	defer r.Unlock()
+	if r.operations == nil {
+		r.operations = make(map[string]*copyOperation)
+	}
+	for id, existing := range r.operations {
+		existing.Lock()
+		expired := !existing.finishedAt.IsZero() && now.Sub(existing.finishedAt) > copyOperationsRetention
+		existing.Unlock()
+		if expired {
+			delete(r.operations, id)
+		}
+	}
+	r.operations[op.status.ID] = op
+}
+
+// get returns an operation only to the user that started it
+func (r *copyOperationsRegistry) get(id, createdBy string) *copyOperation {
+	r.Lock()
+	defer r.Unlock()
+	op, ok := r.operations[id]
+	if !ok || op.createdBy != createdBy {
+		return nil
+	}
+	return op
+}
+
+func registerObjectsCopyHandlers(api *operations.ConsoleAPI) {
+	// copy or move objects
+	api.UserAPICopyObjectsHandler = user_api.CopyObjectsHandlerFunc(func(params user_api.CopyObjectsParams, session *models.Principal) middleware.Responder {
+		operation, err := getCopyObjectsResponse(session, params)
+		if err != nil {
+			return user_api.NewCopyObjectsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewCopyObjectsCreated().WithPayload(operation)
+	})
+	// get copy operation progress
+	api.UserAPIGetCopyOperationHandler = user_api.GetCopyOperationHandlerFunc(func(params user_api.GetCopyOperationParams, session *models.Principal) middleware.Responder {
+		// operations belong to the account, session keys change on every login
+		account, err := getSessionAccountName(session)
+		if err != nil {
+			return user_api.NewGetCopyOperationDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		var operation *models.CopyOperation
+		if op := globalCopyOperations.get(params.OperationID, account); op != nil {
+			operation = op.snapshot()
+		}
+		if operation == nil || operation.SourceBucket != params.BucketName {
+			return user_api.NewGetCopyOperationDefault(404).WithPayload(&models.Error{Code: 404, Message: swag.String("copy operation not found")})
+		}
+		return user_api.NewGetCopyOperationOK().WithPayload(operation)
+	})
+}
+
+// validateCopyObjectsRequest rejects copies that would overwrite their own sources, a single object
+// is checked against the key it would be written to since copying it to a prefix keeps its name.
+func validateCopyObjectsRequest(sourceBucket, destinationBucket, source, destination string) error {
+	if source == "" || destination == "" {
+		return errors.New(500, "error source and destination are required")
+	}
+	if strings.HasSuffix(source, "/") && !strings.HasSuffix(destination, "/") {
+		return errors.New(500, "error a prefix can only be copied to another prefix")
+	}
+	if sourceBucket != destinationBucket {
+		return nil
+	}
+	if source == destination || (!strings.HasSuffix(source, "/") && copyDestinationName(source, destination, source) == source) {
+		return errors.New(500, "error source and destination are the same")
+	}
+	if strings.HasSuffix(source, "/") && strings.HasPrefix(destination, source) {
+		return errors.New(500, "error destination can't be inside the source prefix")
+	}
+	return nil
+}
+
+// copyDestinationName returns the destination key of a source object, objects keep their
+// path relative to the copied prefix and a single object copied to a prefix keeps its name.
+func copyDestinationName(source, destination, objectName string) string {
+	if strings.HasSuffix(source, "/") {
+		return destination + strings.TrimPrefix(objectName, source)
+	}
+	if strings.HasSuffix(destination, "/") {
+		return destination + objectName[strings.LastIndex(objectName, "/")+1:]
+	}
+	return destination
+}
+
+// objectsToCopy returns a channel with the source object or every object under the source prefix
+func objectsToCopy(ctx context.Context, client MinioClient, bucketName, source string) <-chan minio.ObjectInfo {
+	if strings.HasSuffix(source, "/") {
+		return client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: source, Recursive: true})
+	}
+	objectsCh := make(chan minio.ObjectInfo, 1)
+	info, err := client.statObject(ctx, bucketName, source, minio.StatObjectOptions{})
+	if err != nil {
+		info.Err = err
+	}
+	info.Key = source
+	objectsCh <- info
+	close(objectsCh)
+	return objectsCh
+}
+
+// copyObjectServerSide copies an object keeping its metadata and tags, objects too big
+// for CopyObject are composed from parts with their metadata and tags set explicitly.
+func copyObjectServerSide(ctx context.Context, client MinioClient, src minio.CopySrcOptions, dst minio.CopyDestOptions, size int64) (minio.UploadInfo, error) {
+	if size <= maxCopyObjectSize {
+		return client.copyObject(ctx, dst, src)
+	}
+	info, err := client.statObject(ctx, src.Bucket, src.Object, minio.StatObjectOptions{VersionID: src.VersionID})
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	objectTags, err := client.getObjectTagging(ctx, src.Bucket, src.Object, minio.GetObjectTaggingOptions{VersionID: src.VersionID})
+	if err != nil {
+		return minio.UploadInfo{}, err
+	}
+	// replacing the metadata drops the system headers too, so they are set with the user metadata
+	metadata := map[string]string{}
+	for k, v := range info.UserMetadata {
+		metadata[k] = v
+	}
+	if info.ContentType != "" {
+		metadata["Content-Type"] = info.ContentType
+	}
+	for _, header := range []string{"Content-Encoding", "Cache-Control", "Content-Disposition"} {
+		if value := info.Metadata.Get(header); value != "" {
+			metadata[header] = value
+		}
+	}
+	dst.UserMetadata = metadata
+	dst.ReplaceMetadata = true
+	dst.UserTags = objectTags.ToMap()
+	dst.ReplaceTags = true
+	return client.composeObject(ctx, dst, src)
+}
+
+// runCopyOperation copies every source object and, for moves, removes the sources only
+// after all of them were copied, a failed copy never deletes anything.
+func runCopyOperation(ctx context.Context, client MinioClient, op *copyOperation) {
+	req := op.snapshot()
+	var copied []string
+	for object := range objectsToCopy(ctx, client, req.SourceBucket, req.Source) {
+		if object.Err != nil {
+			op.addError(object.Err.Error())
+			op.finish(time.Now(), models.CopyOperationStatusFailed)
+			return
+		}
+		op.update(func(status *models.CopyOperation) { status.Total++ })
+		destinationName := copyDestinationName(req.Source, req.Destination, object.Key)
+		// an object copied onto itself would be removed by a move, so it's reported and kept
+		if req.SourceBucket == req.DestinationBucket && destinationName == object.Key {
+			op.addError(object.Key + ": source and destination are the same")
+			continue
+		}
+		src := minio.CopySrcOptions{Bucket: req.SourceBucket, Object: object.Key}
+		dst := minio.CopyDestOptions{Bucket: req.DestinationBucket, Object: destinationName}
+		if _, err := copyObjectServerSide(ctx, client, src, dst, object.Size); err != nil {
+			op.addError(object.Key + ": " + err.Error())
+			continue
+		}
+		copied = append(copied, object.Key)
+		op.update(func(status *models.CopyOperation) { status.Copied++ })
+	}
+	if op.snapshot().Failed > 0 {
+		if req.Move {
+			op.addError("sources were kept since not every object was copied")
+		}
+		op.finish(time.Now(), models.CopyOperationStatusFailed)
+		return
+	}
+	if req.Move {
+		// sources are only removed if no copy could have been written inside them
+		if err := validateCopyObjectsRequest(req.SourceBucket, req.DestinationBucket, req.Source, req.Destination); err != nil {
+			op.addError(err.Error() + ", sources were kept")
+			op.finish(time.Now(), models.CopyOperationStatusFailed)
+			return
+		}
+		objectsCh := make(chan minio.ObjectInfo)
+		go func() {
+			defer close(objectsCh)
+			for _, name := range copied {
+				objectsCh <- minio.ObjectInfo{Key: name}
+			}
+		}()
+		err := deleteObjects(ctx, client, req.SourceBucket, objectsCh, func(results []deleteObjectResult) error {
+			for _, result := range results {
+				if result.Error != "" {
+					op.addError(result.Name + ": " + result.Error)
+					continue
+				}
+				op.update(func(status *models.CopyOperation) { status.Deleted++ })
+			}
+			return nil
+		})
+		if err != nil {
+			op.addError(err.Error())
+		}
+		if op.snapshot().Failed > 0 {
+			op.finish(time.Now(), models.CopyOperationStatusFailed)
+			return
+		}
+	}
+	op.finish(time.Now(), models.CopyOperationStatusCompleted)
+}
+
+// startCopyOperation validates the request and starts the copy in the background
+func startCopyOperation(ctx context.Context, client MinioClient, registry *copyOperationsRegistry, createdBy, bucketName string, req *models.CopyObjectsRequest) (*models.CopyOperation, error) {
+	destinationBucket := req.DestinationBucket
+	if destinationBucket == "" {
+		destinationBucket = bucketName
+	}
+	if err := validateCopyObjectsRequest(bucketName, destinationBucket, *req.Source, *req.Destination); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	op := &copyOperation{
+		createdBy: createdBy,
+		status: models.CopyOperation{
+			ID:                RandomCharString(16),
+			Status:            models.CopyOperationStatusRunning,
+			Move:              req.Move,
+			SourceBucket:      bucketName,
+			Source:            *req.Source,
+			DestinationBucket: destinationBucket,
+			Destination:       *req.Destination,
+			Errors:            []string{},
+			StartedAt:         now.Format(time.RFC3339),
+		},
+	}
+	registry.add(op, now)
+	go runCopyOperation(ctx, client, op)
+	return op.snapshot(), nil
+}
+
+// getCopyObjectsResponse performs startCopyOperation() and serializes it to the handler's output
+func getCopyObjectsResponse(session *models.Principal, params user_api.CopyObjectsParams) (*models.CopyOperation, error) {
+	account, err := getSessionAccountName(session)
+	if err != nil {
+		log.Println("error getting session account:", err)
+		return nil, err
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	// the operation outlives the request so it doesn't use the request context
+	operation, err := startCopyOperation(context.Background(), minioClient, globalCopyOperations, account, params.BucketName, params.Body)
+	if err != nil {
+		log.Println("error starting copy operation:", err)
+		return nil, err
+	}
+	return operation, nil
+}
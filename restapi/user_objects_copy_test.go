@@ -0,0 +1,229 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioComposeObjectMock func(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error)
+
+// mock function of composeObject()
+func (mc minioClientMock) composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return minioComposeObjectMock(ctx, dst, srcs...)
+}
+
+func TestValidateCopyObjectsRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: validateCopyObjectsRequest() accepts copies to other names, prefixes and buckets
+	assert.Nil(validateCopyObjectsRequest("bucket1", "bucket1", "a.txt", "b.txt"))
+	assert.Nil(validateCopyObjectsRequest("bucket1", "bucket1", "photos/", "archive/photos/"))
+	assert.Nil(validateCopyObjectsRequest("bucket1", "bucket2", "photos/", "photos/"))
+
+	// Test-2: validateCopyObjectsRequest() rejects copies over the sources
+	if err := validateCopyObjectsRequest("bucket1", "bucket1", "a.txt", "a.txt"); assert.Error(err) {
+		assert.Equal("error source and destination are the same", err.Error())
+	}
+	if err := validateCopyObjectsRequest("bucket1", "bucket1", "a/x", "a/"); assert.Error(err) {
+		assert.Equal("error source and destination are the same", err.Error())
+	}
+	if err := validateCopyObjectsRequest("bucket1", "bucket1", "photos/", "photos/2020/"); assert.Error(err) {
+		assert.Equal("error destination can't be inside the source prefix", err.Error())
+	}
+	if err := validateCopyObjectsRequest("bucket1", "bucket2", "photos/", "photos"); assert.Error(err) {
+		assert.Equal("error a prefix can only be copied to another prefix", err.Error())
+	}
+
+	// Test-3: copyDestinationName() keeps the relative paths
+	assert.Equal("archive/2020/a.jpg", copyDestinationName("photos/", "archive/", "photos/2020/a.jpg"))
+	assert.Equal("archive/a.jpg", copyDestinationName("photos/a.jpg", "archive/", "photos/a.jpg"))
+	assert.Equal("b.jpg", copyDestinationName("photos/a.jpg", "b.jpg", "photos/a.jpg"))
+}
+
+func TestRunCopyOperation(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	newOperation := func(move bool) *copyOperation {
+		return &copyOperation{status: models.CopyOperation{
+			ID:                "op1",
+			Move:              move,
+			SourceBucket:      "bucket1",
+			Source:            "photos/",
+			DestinationBucket: "bucket2",
+			Destination:       "archive/",
+			Errors:            []string{},
+		}}
+	}
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 2)
+		ch <- minio.ObjectInfo{Key: "photos/a.jpg", Size: 10}
+		ch <- minio.ObjectInfo{Key: "photos/2020/b.jpg", Size: 20}
+		close(ch)
+		return ch
+	}
+	var copied []string
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		copied = append(copied, dst.Bucket+"/"+dst.Object)
+		return minio.UploadInfo{}, nil
+	}
+	var removed []string
+	minioRemoveObjectsMock = func(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+		for object := range objectsCh {
+			removed = append(removed, object.Key)
+		}
+		errCh := make(chan minio.RemoveObjectError)
+		close(errCh)
+		return errCh
+	}
+
+	// Test-1: runCopyOperation() copies every object under the prefix
+	function := "runCopyOperation()"
+	op := newOperation(false)
+	runCopyOperation(ctx, minClient, op)
+	status := op.snapshot()
+	assert.Equal(models.CopyOperationStatusCompleted, status.Status, function)
+	assert.Equal(int64(2), status.Total)
+	assert.Equal(int64(2), status.Copied)
+	assert.Equal([]string{"bucket2/archive/a.jpg", "bucket2/archive/2020/b.jpg"}, copied)
+	assert.Nil(removed)
+
+	// Test-2: runCopyOperation() removes the sources of a move once everything was copied
+	op = newOperation(true)
+	runCopyOperation(ctx, minClient, op)
+	status = op.snapshot()
+	assert.Equal(models.CopyOperationStatusCompleted, status.Status)
+	assert.Equal(int64(2), status.Deleted)
+	assert.Equal([]string{"photos/a.jpg", "photos/2020/b.jpg"}, removed)
+
+	// Test-3: runCopyOperation() keeps every source of a move when a copy fails
+	removed = nil
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		if src.Object == "photos/2020/b.jpg" {
+			return minio.UploadInfo{}, errors.New("Access Denied.")
+		}
+		return minio.UploadInfo{}, nil
+	}
+	op = newOperation(true)
+	runCopyOperation(ctx, minClient, op)
+	status = op.snapshot()
+	assert.Equal(models.CopyOperationStatusFailed, status.Status)
+	assert.Equal(int64(1), status.Copied)
+	assert.Equal([]string{"photos/2020/b.jpg: Access Denied.", "sources were kept since not every object was copied"}, status.Errors)
+	assert.Nil(removed)
+	assert.NotEmpty(status.FinishedAt)
+
+	// Test-4: runCopyOperation() never copies an object onto itself nor removes it on a move
+	copied, removed = nil, nil
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		copied = append(copied, dst.Bucket+"/"+dst.Object)
+		return minio.UploadInfo{}, nil
+	}
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{Key: objectName, Size: 10}, nil
+	}
+	op = newOperation(true)
+	op.status.Source = "a/x"
+	op.status.DestinationBucket = "bucket1"
+	op.status.Destination = "a/"
+	runCopyOperation(ctx, minClient, op)
+	status = op.snapshot()
+	assert.Equal(models.CopyOperationStatusFailed, status.Status)
+	assert.Equal([]string{"a/x: source and destination are the same", "sources were kept since not every object was copied"}, status.Errors)
+	assert.Nil(copied)
+	assert.Nil(removed)
+
+	// Test-5: runCopyOperation() keeps the sources of a move to a prefix inside them
+	op = newOperation(true)
+	op.status.DestinationBucket = "bucket1"
+	op.status.Destination = "photos/2020/"
+	runCopyOperation(ctx, minClient, op)
+	status = op.snapshot()
+	assert.Equal(models.CopyOperationStatusFailed, status.Status)
+	assert.Equal([]string{"error destination can't be inside the source prefix, sources were kept"}, status.Errors)
+	assert.Nil(removed)
+
+	// Test-6: runCopyOperation() composes objects too big for CopyObject keeping metadata and tags
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 1)
+		ch <- minio.ObjectInfo{Key: "photos/big.iso", Size: maxCopyObjectSize + 1}
+		close(ch)
+		return ch
+	}
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{
+			ContentType:  "application/x-iso9660-image",
+			Metadata:     http.Header{"Cache-Control": []string{"no-cache"}, "Content-Disposition": []string{"attachment"}},
+			UserMetadata: minio.StringMap{"Owner": "records"},
+		}, nil
+	}
+	minioGetObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+		return tags.MapToObjectTags(map[string]string{"project": "console"})
+	}
+	var composed minio.CopyDestOptions
+	minioComposeObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+		composed = dst
+		return minio.UploadInfo{}, nil
+	}
+	op = newOperation(false)
+	runCopyOperation(ctx, minClient, op)
+	assert.Equal(models.CopyOperationStatusCompleted, op.snapshot().Status)
+	assert.Equal("archive/big.iso", composed.Object)
+	assert.True(composed.ReplaceMetadata)
+	assert.Equal(map[string]string{
+		"Owner":               "records",
+		"Content-Type":        "application/x-iso9660-image",
+		"Cache-Control":       "no-cache",
+		"Content-Disposition": "attachment",
+	}, composed.UserMetadata)
+	assert.Equal(map[string]string{"project": "console"}, composed.UserTags)
+}
+
+func TestCopyOperationsRegistry(t *testing.T) {
+	assert := assert.New(t)
+	registry := &copyOperationsRegistry{}
+	now := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	// Test-1: get() only returns operations to the user that started them
+	op := &copyOperation{createdBy: "user1", status: models.CopyOperation{ID: "op1"}}
+	registry.add(op, now)
+	assert.Equal(op, registry.get("op1", "user1"))
+	assert.Nil(registry.get("op1", "user2"))
+
+	// Test-2: add() drops operations finished long ago
+	op.finish(now, models.CopyOperationStatusCompleted)
+	registry.add(&copyOperation{createdBy: "user1", status: models.CopyOperation{ID: "op2"}}, now.Add(2*time.Hour))
+	assert.Nil(registry.get("op1", "user1"))
+	assert.NotNil(registry.get("op2", "user1"))
+
+	// Test-3: startCopyOperation() validates the request before starting
+	_, err := startCopyOperation(context.Background(), minioClientMock{}, registry, "user1", "bucket1", &models.CopyObjectsRequest{Source: swag.String("a.txt"), Destination: swag.String("a.txt")})
+	if assert.Error(err) {
+		assert.Equal("error source and destination are the same", err.Error())
+	}
+}
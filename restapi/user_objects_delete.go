@@ -0,0 +1,219 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/minio/minio-go/v7"
+)
+
+// removeObjectsBatchSize is the number of objects sent on every RemoveObjects call,
+// it matches the maximum accepted by a single S3 multi-object delete request.
+const removeObjectsBatchSize = 1000
+
+type deleteObjectsOptions struct {
+	BucketName string
+	// every object under Prefix is deleted
+	Prefix string
+	// Objects are individual keys to be deleted
+	Objects []string
+}
+
+type deleteObjectResult struct {
+	Name      string `json:"name"`
+	VersionID string `json:"versionID,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// deleteObjectsStatus is sent to the client after every processed batch
+type deleteObjectsStatus struct {
+	Results []deleteObjectResult `json:"results"`
+	// running counters since the start of the operation
+	Deleted int64 `json:"deleted"`
+	Failed  int64 `json:"failed"`
+	// Done is true on the last message
+	Done bool `json:"done"`
+}
+
+// startDeleteObjects deletes the requested keys and every object under the requested prefix,
+// the result of each batch is streamed through the websocket connection along with the running counts.
+func startDeleteObjects(ctx context.Context, conn WSConn, client MinioClient, opts *deleteObjectsOptions) error {
+	status := deleteObjectsStatus{}
+	sendStatus := func() error {
+		bytes, err := json.Marshal(status)
+		if err != nil {
+			log.Println("error on json.Marshal:", err)
+			return err
+		}
+		// Send Message through websocket connection
+		if err := conn.writeMessage(websocket.TextMessage, bytes); err != nil {
+			log.Println("error writeMessage:", err)
+			return err
+		}
+		return nil
+	}
+	err := deleteObjects(ctx, client, opts.BucketName, objectsToDelete(ctx, client, opts), func(results []deleteObjectResult) error {
+		status.Results = results
+		for _, result := range results {
+			if result.Error != "" {
+				status.Failed++
+			} else {
+				status.Deleted++
+			}
+		}
+		return sendStatus()
+	})
+	if err != nil {
+		log.Println("error deleting objects:", err)
+		return err
+	}
+	status.Results = []deleteObjectResult{}
+	status.Done = true
+	return sendStatus()
+}
+
+// objectsToDelete returns a channel with the individual keys followed by the objects listed under the prefix
+func objectsToDelete(ctx context.Context, client MinioClient, opts *deleteObjectsOptions) <-chan minio.ObjectInfo {
+	objectsCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectsCh)
+		for _, object := range opts.Objects {
+			select {
+			case objectsCh <- minio.ObjectInfo{Key: object}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if opts.Prefix == "" {
+			return
+		}
+		for object := range client.listObjects(ctx, opts.BucketName, minio.ListObjectsOptions{Prefix: opts.Prefix, Recursive: true}) {
+			select {
+			case objectsCh <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return objectsCh
+}
+
+// deleteObjects groups the objects received on objectsCh in batches and removes every batch
+// with a single RemoveObjects call, onBatch is called with the results of each batch.
+func deleteObjects(ctx context.Context, client MinioClient, bucketName string, objectsCh <-chan minio.ObjectInfo, onBatch func([]deleteObjectResult) error) error {
+	var batch []minio.ObjectInfo
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		results, err := removeObjectsBatch(ctx, client, bucketName, batch)
+		if err != nil {
+			return err
+		}
+		batch = nil
+		return onBatch(results)
+	}
+	for object := range objectsCh {
+		if object.Err != nil {
+			return object.Err
+		}
+		batch = append(batch, object)
+		if len(batch) == removeObjectsBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	// the listing stops early if the client went away
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// removeObjectsBatch removes a batch of objects and returns the result for each one of them,
+// RemoveObjects only reports failures so every object without an error was deleted.
+func removeObjectsBatch(ctx context.Context, client MinioClient, bucketName string, batch []minio.ObjectInfo) ([]deleteObjectResult, error) {
+	objectsCh := make(chan minio.ObjectInfo, len(batch))
+	for _, object := range batch {
+		objectsCh <- object
+	}
+	close(objectsCh)
+	failed := make(map[string]string)
+	for rErr := range client.removeObjects(ctx, bucketName, objectsCh, minio.RemoveObjectsOptions{}) {
+		// errors not related to a particular object fail the whole request
+		if rErr.ObjectName == "" {
+			return nil, rErr.Err
+		}
+		failed[rErr.ObjectName+rErr.VersionID] = rErr.Err.Error()
+	}
+	var results []deleteObjectResult
+	for _, object := range batch {
+		results = append(results, deleteObjectResult{
+			Name:      object.Key,
+			VersionID: object.VersionID,
+			Error:     failed[object.Key+object.VersionID],
+		})
+	}
+	return results, nil
+}
+
+// emptyBucket removes every object of a bucket including all their versions
+func emptyBucket(ctx context.Context, client MinioClient, bucketName string) error {
+	objectsCh := client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Recursive: true, WithVersions: true})
+	return deleteObjects(ctx, client, bucketName, objectsCh, func(results []deleteObjectResult) error {
+		for _, result := range results {
+			if result.Error != "" {
+				return errors.New(result.Error)
+			}
+		}
+		return nil
+	})
+}
+
+// getDeleteObjectsOptionsFromReq gets bucket name, prefix and keys from a websocket
+// delete path.
+// path come as : `/delete/bucket1` and prefix and keys come on request form
+// e.g. `/delete/bucket1?prefix=photos/&key=a.txt&key=b.txt`
+func getDeleteObjectsOptionsFromReq(req *http.Request) (*deleteObjectsOptions, error) {
+	opts := deleteObjectsOptions{}
+	re := regexp.MustCompile(`(/delete/)(.*?)(\?.*?$|$)`)
+	matches := re.FindAllSubmatch([]byte(req.URL.Path), -1)
+	// matches comes as e.g.
+	// [["...", "/delete/" "bucket1" ""]]
+	if len(matches) == 0 || strings.TrimSpace(string(matches[0][2])) == "" {
+		return nil, errors.New("error bucket name not in request")
+	}
+	opts.BucketName = strings.TrimSpace(string(matches[0][2]))
+	if err := req.ParseForm(); err != nil {
+		return nil, err
+	}
+	opts.Prefix = req.FormValue("prefix")
+	opts.Objects = req.Form["key"]
+	if opts.Prefix == "" && len(opts.Objects) == 0 {
+		return nil, errors.New("error prefix or keys to delete not in request")
+	}
+	return &opts, nil
+}
@@ -0,0 +1,179 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioRemoveObjectsMock func(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError
+
+// mock function of removeObjects()
+func (mc minioClientMock) removeObjects(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+	return minioRemoveObjectsMock(ctx, bucketName, objectsCh, opts)
+}
+
+func TestDeleteObjects(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	mockWSConn := mockConn{}
+	function := "startDeleteObjects()"
+
+	var removed []string
+	minioRemoveObjectsMock = func(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+		errCh := make(chan minio.RemoveObjectError, removeObjectsBatchSize)
+		for object := range objectsCh {
+			if object.Key == "photos/locked.txt" {
+				errCh <- minio.RemoveObjectError{ObjectName: object.Key, Err: errors.New("Access Denied.")}
+				continue
+			}
+			removed = append(removed, object.Key)
+		}
+		close(errCh)
+		return errCh
+	}
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 3)
+		ch <- minio.ObjectInfo{Key: "photos/a.txt"}
+		ch <- minio.ObjectInfo{Key: "photos/locked.txt"}
+		ch <- minio.ObjectInfo{Key: "photos/sub/b.txt"}
+		close(ch)
+		return ch
+	}
+	var messages []deleteObjectsStatus
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		var status deleteObjectsStatus
+		if err := json.Unmarshal(data, &status); err != nil {
+			return err
+		}
+		messages = append(messages, status)
+		return nil
+	}
+
+	// Test-1: startDeleteObjects() deletes keys and prefixes and sends the progress
+	opts := &deleteObjectsOptions{BucketName: "bucket1", Prefix: "photos/", Objects: []string{"file.txt"}}
+	if err := startDeleteObjects(ctx, mockWSConn, minClient, opts); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal([]string{"file.txt", "photos/a.txt", "photos/sub/b.txt"}, removed)
+	if assert.Equal(2, len(messages), fmt.Sprintf("Failed on %s: number of messages is not the same", function)) {
+		assert.Equal(4, len(messages[0].Results))
+		assert.Equal("Access Denied.", messages[0].Results[2].Error)
+		assert.False(messages[0].Done)
+		assert.Equal(int64(3), messages[1].Deleted)
+		assert.Equal(int64(1), messages[1].Failed)
+		assert.True(messages[1].Done)
+	}
+
+	// Test-2: startDeleteObjects() removes objects in batches
+	removed = nil
+	messages = nil
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo)
+		go func() {
+			defer close(ch)
+			for i := 0; i < removeObjectsBatchSize+1; i++ {
+				ch <- minio.ObjectInfo{Key: fmt.Sprintf("photos/%d.txt", i)}
+			}
+		}()
+		return ch
+	}
+	opts = &deleteObjectsOptions{BucketName: "bucket1", Prefix: "photos/"}
+	if err := startDeleteObjects(ctx, mockWSConn, minClient, opts); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(removeObjectsBatchSize+1, len(removed))
+	if assert.Equal(3, len(messages), fmt.Sprintf("Failed on %s: number of messages is not the same", function)) {
+		assert.Equal(removeObjectsBatchSize, len(messages[0].Results))
+		assert.Equal(1, len(messages[1].Results))
+		assert.Equal(int64(removeObjectsBatchSize+1), messages[2].Deleted)
+	}
+
+	// Test-3: startDeleteObjects() returns errors not related to an object
+	minioRemoveObjectsMock = func(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+		errCh := make(chan minio.RemoveObjectError, 1)
+		errCh <- minio.RemoveObjectError{Err: errors.New("The specified bucket does not exist")}
+		close(errCh)
+		return errCh
+	}
+	opts = &deleteObjectsOptions{BucketName: "bucket1", Objects: []string{"file.txt"}}
+	if err := startDeleteObjects(ctx, mockWSConn, minClient, opts); assert.Error(err) {
+		assert.Equal("The specified bucket does not exist", err.Error())
+	}
+
+	// Test-4: emptyBucket() returns the first object error
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 2)
+		ch <- minio.ObjectInfo{Key: "a.txt", VersionID: "v1"}
+		ch <- minio.ObjectInfo{Key: "a.txt", VersionID: "v2"}
+		close(ch)
+		return ch
+	}
+	minioRemoveObjectsMock = func(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+		errCh := make(chan minio.RemoveObjectError, 2)
+		for object := range objectsCh {
+			if object.VersionID == "v2" {
+				errCh <- minio.RemoveObjectError{ObjectName: object.Key, VersionID: object.VersionID, Err: errors.New("Object is WORM protected and cannot be overwritten")}
+			}
+		}
+		close(errCh)
+		return errCh
+	}
+	if err := emptyBucket(ctx, minClient, "bucket1"); assert.Error(err) {
+		assert.Equal("Object is WORM protected and cannot be overwritten", err.Error())
+	}
+}
+
+func TestGetDeleteObjectsOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+	function := "getDeleteObjectsOptionsFromReq()"
+
+	// Test-1: getDeleteObjectsOptionsFromReq() returns bucket, prefix and keys
+	u, _ := url.Parse("http://localhost/ws/delete/bucket1?prefix=photos/&key=a.txt&key=b.txt")
+	opts, err := getDeleteObjectsOptionsFromReq(&http.Request{URL: u})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("bucket1", opts.BucketName)
+	assert.Equal("photos/", opts.Prefix)
+	assert.Equal([]string{"a.txt", "b.txt"}, opts.Objects)
+
+	// Test-2: getDeleteObjectsOptionsFromReq() requires something to delete
+	u, _ = url.Parse("http://localhost/ws/delete/bucket1")
+	_, err = getDeleteObjectsOptionsFromReq(&http.Request{URL: u})
+	if assert.Error(err) {
+		assert.Equal("error prefix or keys to delete not in request", err.Error())
+	}
+
+	// Test-3: getDeleteObjectsOptionsFromReq() requires a bucket name
+	u, _ = url.Parse("http://localhost/ws/delete/?prefix=photos/")
+	_, err = getDeleteObjectsOptionsFromReq(&http.Request{URL: u})
+	if assert.Error(err) {
+		assert.Equal("error bucket name not in request", err.Error())
+	}
+}
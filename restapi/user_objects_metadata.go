@@ -0,0 +1,307 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+func registerObjectMetadataHandlers(api *operations.ConsoleAPI) {
+	// get object tags
+	api.UserAPIGetObjectTagsHandler = user_api.GetObjectTagsHandlerFunc(func(params user_api.GetObjectTagsParams, session *models.Principal) middleware.Responder {
+		objectTags, err := getObjectTagsResponse(session, params)
+		if err != nil {
+			return user_api.NewGetObjectTagsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewGetObjectTagsOK().WithPayload(objectTags)
+	})
+	// replace object tags
+	api.UserAPIPutObjectTagsHandler = user_api.PutObjectTagsHandlerFunc(func(params user_api.PutObjectTagsParams, session *models.Principal) middleware.Responder {
+		objectTags, err := getPutObjectTagsResponse(session, params)
+		if err != nil {
+			return user_api.NewPutObjectTagsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewPutObjectTagsOK().WithPayload(objectTags)
+	})
+	// get object metadata
+	api.UserAPIGetObjectMetadataHandler = user_api.GetObjectMetadataHandlerFunc(func(params user_api.GetObjectMetadataParams, session *models.Principal) middleware.Responder {
+		objectMetadata, err := getObjectMetadataResponse(session, params)
+		if err != nil {
+			return user_api.NewGetObjectMetadataDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewGetObjectMetadataOK().WithPayload(objectMetadata)
+	})
+	// set or clear object legal hold
+	api.UserAPIPutObjectLegalHoldHandler = user_api.PutObjectLegalHoldHandlerFunc(func(params user_api.PutObjectLegalHoldParams, session *models.Principal) middleware.Responder {
+		if err := getPutObjectLegalHoldResponse(session, params); err != nil {
+			return user_api.NewPutObjectLegalHoldDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewPutObjectLegalHoldNoContent()
+	})
+	// set or extend object retention
+	api.UserAPIPutObjectRetentionHandler = user_api.PutObjectRetentionHandlerFunc(func(params user_api.PutObjectRetentionParams, session *models.Principal) middleware.Responder {
+		if err := getPutObjectRetentionResponse(session, params); err != nil {
+			return user_api.NewPutObjectRetentionDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewPutObjectRetentionNoContent()
+	})
+}
+
+// getObjectTags returns the tag set of an object version
+func getObjectTags(ctx context.Context, client MinioClient, bucketName, objectName, versionID string) (*models.ObjectTags, error) {
+	objectTags, err := client.getObjectTagging(ctx, bucketName, objectName, minio.GetObjectTaggingOptions{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	return &models.ObjectTags{Tags: objectTags.ToMap()}, nil
+}
+
+// putObjectTags replaces the tag set of an object version, an empty set removes every tag
+func putObjectTags(ctx context.Context, client MinioClient, bucketName, objectName, versionID string, tagMap map[string]string) (*models.ObjectTags, error) {
+	if len(tagMap) == 0 {
+		if err := client.removeObjectTagging(ctx, bucketName, objectName, minio.RemoveObjectTaggingOptions{VersionID: versionID}); err != nil {
+			return nil, err
+		}
+		return &models.ObjectTags{Tags: map[string]string{}}, nil
+	}
+	// tags are validated against the S3 limits before sending them
+	objectTags, err := tags.MapToObjectTags(tagMap)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.putObjectTagging(ctx, bucketName, objectName, objectTags, minio.PutObjectTaggingOptions{VersionID: versionID}); err != nil {
+		return nil, err
+	}
+	return &models.ObjectTags{Tags: objectTags.ToMap()}, nil
+}
+
+// getObjectMetadata returns the user-defined metadata, tags and system headers of an object version,
+// legal hold and retention are read from the object lock headers.
+func getObjectMetadata(ctx context.Context, client MinioClient, bucketName, objectName, versionID string) (*models.ObjectMetadata, error) {
+	info, err := client.statObject(ctx, bucketName, objectName, minio.StatObjectOptions{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]string{}
+	for key, values := range info.Metadata {
+		// user metadata is returned on its own
+		if strings.HasPrefix(strings.ToLower(key), "x-amz-meta-") {
+			continue
+		}
+		headers[key] = strings.Join(values, ",")
+	}
+	userMetadata := map[string]string{}
+	for key, value := range info.UserMetadata {
+		userMetadata[key] = value
+	}
+	return &models.ObjectMetadata{
+		Name:          info.Key,
+		VersionID:     info.VersionID,
+		Size:          info.Size,
+		Etag:          info.ETag,
+		ContentType:   info.ContentType,
+		LastModified:  info.LastModified.Format(time.RFC3339),
+		StorageClass:  info.StorageClass,
+		UserMetadata:  userMetadata,
+		Headers:       headers,
+		Tags:          info.UserTags,
+		LegalHold:     info.Metadata.Get("X-Amz-Object-Lock-Legal-Hold"),
+		RetentionMode: info.Metadata.Get("X-Amz-Object-Lock-Mode"),
+		RetainUntil:   info.Metadata.Get("X-Amz-Object-Lock-Retain-Until-Date"),
+	}, nil
+}
+
+// setObjectLegalHold sets or clears the legal hold of an object version,
+// the bucket must have object locking enabled.
+func setObjectLegalHold(ctx context.Context, client MinioClient, bucketName, objectName, versionID, status string) error {
+	var legalHold minio.LegalHoldStatus
+	switch status {
+	case models.PutObjectLegalHoldRequestStatusEnabled:
+		legalHold = minio.LegalHoldEnabled
+	case models.PutObjectLegalHoldRequestStatusDisabled:
+		legalHold = minio.LegalHoldDisabled
+	default:
+		return errors.New(500, "error legal hold status %s not supported", status)
+	}
+	return client.putObjectLegalHold(ctx, bucketName, objectName, minio.PutObjectLegalHoldOptions{
+		VersionID: versionID,
+		Status:    &legalHold,
+	})
+}
+
+// setObjectRetention sets the retention of an object version, compliance retentions can only be
+// extended while governance retentions can be shortened with governance bypass.
+func setObjectRetention(ctx context.Context, client MinioClient, bucketName, objectName, versionID string, req *models.PutObjectRetentionRequest, now time.Time) error {
+	mode := minio.RetentionMode(strings.ToUpper(*req.Mode))
+	if !mode.IsValid() {
+		return errors.New(500, "error retention mode %s not supported", *req.Mode)
+	}
+	retainUntil, err := time.Parse(time.RFC3339, *req.RetainUntil)
+	if err != nil {
+		return errors.New(500, "error retain until date must be a RFC3339 date")
+	}
+	if !retainUntil.After(now) {
+		return errors.New(500, "error retain until date must be in the future")
+	}
+	retainUntil = retainUntil.UTC()
+	return client.putObjectRetention(ctx, bucketName, objectName, minio.PutObjectRetentionOptions{
+		GovernanceBypass: req.GovernanceBypass,
+		Mode:             &mode,
+		RetainUntilDate:  &retainUntil,
+		VersionID:        versionID,
+	})
+}
+
+// getObjectTagsResponse performs getObjectTags() and serializes it to the handler's output
+func getObjectTagsResponse(session *models.Principal, params user_api.GetObjectTagsParams) (*models.ObjectTags, error) {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return nil, errors.New(500, "error object name not in request")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	objectTags, err := getObjectTags(ctx, minioClient, params.BucketName, *params.Prefix, swag.StringValue(params.VersionID))
+	if err != nil {
+		log.Println("error getting object tags:", err)
+		return nil, err
+	}
+	return objectTags, nil
+}
+
+// getPutObjectTagsResponse performs putObjectTags() and serializes it to the handler's output
+func getPutObjectTagsResponse(session *models.Principal, params user_api.PutObjectTagsParams) (*models.ObjectTags, error) {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return nil, errors.New(500, "error object name not in request")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	objectTags, err := putObjectTags(ctx, minioClient, params.BucketName, *params.Prefix, swag.StringValue(params.VersionID), params.Body.Tags)
+	if err != nil {
+		log.Println("error putting object tags:", err)
+		return nil, err
+	}
+	return objectTags, nil
+}
+
+// getObjectMetadataResponse performs getObjectMetadata() and serializes it to the handler's output
+func getObjectMetadataResponse(session *models.Principal, params user_api.GetObjectMetadataParams) (*models.ObjectMetadata, error) {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return nil, errors.New(500, "error object name not in request")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	objectMetadata, err := getObjectMetadata(ctx, minioClient, params.BucketName, *params.Prefix, swag.StringValue(params.VersionID))
+	if err != nil {
+		log.Println("error getting object metadata:", err)
+		return nil, err
+	}
+	return objectMetadata, nil
+}
+
+// getPutObjectLegalHoldResponse performs setObjectLegalHold()
+func getPutObjectLegalHoldResponse(session *models.Principal, params user_api.PutObjectLegalHoldParams) error {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return errors.New(500, "error object name not in request")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := setObjectLegalHold(ctx, minioClient, params.BucketName, *params.Prefix, swag.StringValue(params.VersionID), *params.Body.Status); err != nil {
+		log.Println("error setting object legal hold:", err)
+		return err
+	}
+	return nil
+}
+
+// getPutObjectRetentionResponse performs setObjectRetention()
+func getPutObjectRetentionResponse(session *models.Principal, params user_api.PutObjectRetentionParams) error {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return errors.New(500, "error object name not in request")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	if err := setObjectRetention(ctx, minioClient, params.BucketName, *params.Prefix, swag.StringValue(params.VersionID), params.Body, time.Now()); err != nil {
+		log.Println("error setting object retention:", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioStatObjectMock func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+var minioGetObjectTaggingMock func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error)
+var minioPutObjectTaggingMock func(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error
+var minioRemoveObjectTaggingMock func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectTaggingOptions) error
+var minioPutObjectLegalHoldMock func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectLegalHoldOptions) error
+var minioPutObjectRetentionMock func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error
+
+// mock function of statObject()
+func (mc minioClientMock) statObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return minioStatObjectMock(ctx, bucketName, objectName, opts)
+}
+
+// mock function of getObjectTagging()
+func (mc minioClientMock) getObjectTagging(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+	return minioGetObjectTaggingMock(ctx, bucketName, objectName, opts)
+}
+
+// mock function of putObjectTagging()
+func (mc minioClientMock) putObjectTagging(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error {
+	return minioPutObjectTaggingMock(ctx, bucketName, objectName, otags, opts)
+}
+
+// mock function of removeObjectTagging()
+func (mc minioClientMock) removeObjectTagging(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectTaggingOptions) error {
+	return minioRemoveObjectTaggingMock(ctx, bucketName, objectName, opts)
+}
+
+// mock function of putObjectLegalHold()
+func (mc minioClientMock) putObjectLegalHold(ctx context.Context, bucketName, objectName string, opts minio.PutObjectLegalHoldOptions) error {
+	return minioPutObjectLegalHoldMock(ctx, bucketName, objectName, opts)
+}
+
+// mock function of putObjectRetention()
+func (mc minioClientMock) putObjectRetention(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error {
+	return minioPutObjectRetentionMock(ctx, bucketName, objectName, opts)
+}
+
+func TestObjectTags(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	// Test-1: getObjectTags() returns the tags of the object version
+	function := "getObjectTags()"
+	var gotVersionID string
+	minioGetObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+		gotVersionID = opts.VersionID
+		return tags.MapToObjectTags(map[string]string{"project": "console"})
+	}
+	objectTags, err := getObjectTags(ctx, minClient, "bucket1", "report.pdf", "v1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("v1", gotVersionID)
+	assert.Equal(map[string]string{"project": "console"}, objectTags.Tags)
+
+	// Test-2: putObjectTags() replaces the tags
+	function = "putObjectTags()"
+	var gotTags *tags.Tags
+	minioPutObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error {
+		gotTags = otags
+		return nil
+	}
+	objectTags, err = putObjectTags(ctx, minClient, "bucket1", "report.pdf", "", map[string]string{"a": "1", "b": "2"})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(map[string]string{"a": "1", "b": "2"}, gotTags.ToMap())
+	assert.Equal(map[string]string{"a": "1", "b": "2"}, objectTags.Tags)
+
+	// Test-3: putObjectTags() removes the tags with an empty set
+	removed := false
+	minioRemoveObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectTaggingOptions) error {
+		removed = true
+		return nil
+	}
+	if _, err = putObjectTags(ctx, minClient, "bucket1", "report.pdf", "", map[string]string{}); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.True(removed)
+
+	// Test-4: putObjectTags() validates the tags before sending them
+	_, err = putObjectTags(ctx, minClient, "bucket1", "report.pdf", "", map[string]string{"": "empty"})
+	assert.Error(err)
+
+	// Test-5: getObjectTags() handles errors correctly
+	minioGetObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+		return nil, errors.New("error")
+	}
+	if _, err = getObjectTags(ctx, minClient, "bucket1", "report.pdf", ""); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestGetObjectMetadata(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "getObjectMetadata()"
+
+	// Test-1: getObjectMetadata() splits user metadata from system headers
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{
+			Key:          objectName,
+			VersionID:    opts.VersionID,
+			Size:         10,
+			ContentType:  "application/pdf",
+			LastModified: time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC),
+			Metadata: http.Header{
+				"Content-Type":                        []string{"application/pdf"},
+				"X-Amz-Meta-Owner":                    []string{"records"},
+				"X-Amz-Object-Lock-Legal-Hold":        []string{"ON"},
+				"X-Amz-Object-Lock-Mode":              []string{"COMPLIANCE"},
+				"X-Amz-Object-Lock-Retain-Until-Date": []string{"2030-01-01T00:00:00Z"},
+			},
+			UserMetadata: minio.StringMap{"Owner": "records"},
+			UserTags:     map[string]string{"project": "console"},
+		}, nil
+	}
+	objectMetadata, err := getObjectMetadata(ctx, minClient, "bucket1", "report.pdf", "v1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("v1", objectMetadata.VersionID)
+	assert.Equal("2020-08-01T10:00:00Z", objectMetadata.LastModified)
+	assert.Equal(map[string]string{"Owner": "records"}, objectMetadata.UserMetadata)
+	assert.NotContains(objectMetadata.Headers, "X-Amz-Meta-Owner")
+	assert.Equal("application/pdf", objectMetadata.Headers["Content-Type"])
+	assert.Equal(map[string]string{"project": "console"}, objectMetadata.Tags)
+	assert.Equal("ON", objectMetadata.LegalHold)
+	assert.Equal("COMPLIANCE", objectMetadata.RetentionMode)
+	assert.Equal("2030-01-01T00:00:00Z", objectMetadata.RetainUntil)
+
+	// Test-2: getObjectMetadata() handles errors correctly
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{}, errors.New("error")
+	}
+	if _, err = getObjectMetadata(ctx, minClient, "bucket1", "report.pdf", ""); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestObjectLegalHoldAndRetention(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	// Test-1: setObjectLegalHold() enables the legal hold of a version
+	function := "setObjectLegalHold()"
+	var gotLegalHold minio.PutObjectLegalHoldOptions
+	minioPutObjectLegalHoldMock = func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectLegalHoldOptions) error {
+		gotLegalHold = opts
+		return nil
+	}
+	if err := setObjectLegalHold(ctx, minClient, "bucket1", "report.pdf", "v1", "enabled"); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("v1", gotLegalHold.VersionID)
+	assert.Equal(minio.LegalHoldEnabled, *gotLegalHold.Status)
+
+	// Test-2: setObjectLegalHold() rejects unknown statuses
+	if err := setObjectLegalHold(ctx, minClient, "bucket1", "report.pdf", "", "on"); assert.Error(err) {
+		assert.Equal("error legal hold status on not supported", err.Error())
+	}
+
+	// Test-3: setObjectRetention() sets the retention of a version
+	function = "setObjectRetention()"
+	now := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	var gotRetention minio.PutObjectRetentionOptions
+	minioPutObjectRetentionMock = func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error {
+		gotRetention = opts
+		return nil
+	}
+	req := &models.PutObjectRetentionRequest{Mode: swag.String("compliance"), RetainUntil: swag.String("2021-01-01T00:00:00Z")}
+	if err := setObjectRetention(ctx, minClient, "bucket1", "report.pdf", "v1", req, now); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(minio.Compliance, *gotRetention.Mode)
+	assert.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), *gotRetention.RetainUntilDate)
+	assert.Equal("v1", gotRetention.VersionID)
+
+	// Test-4: setObjectRetention() rejects dates in the past and invalid dates
+	req.RetainUntil = swag.String("2020-01-01T00:00:00Z")
+	if err := setObjectRetention(ctx, minClient, "bucket1", "report.pdf", "", req, now); assert.Error(err) {
+		assert.Equal("error retain until date must be in the future", err.Error())
+	}
+	req.RetainUntil = swag.String("2021-01-01")
+	if err := setObjectRetention(ctx, minClient, "bucket1", "report.pdf", "", req, now); assert.Error(err) {
+		assert.Equal("error retain until date must be a RFC3339 date", err.Error())
+	}
+
+	// Test-5: setObjectRetention() handles errors correctly
+	minioPutObjectRetentionMock = func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error {
+		return errors.New("error")
+	}
+	req.RetainUntil = swag.String("2021-01-01T00:00:00Z")
+	if err := setObjectRetention(ctx, minClient, "bucket1", "report.pdf", "", req, now); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
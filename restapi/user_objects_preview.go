@@ -0,0 +1,181 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+)
+
+const (
+	// defaultPreviewMaxSize is the size of the biggest object that can be previewed when
+	// CONSOLE_PREVIEW_MAX_SIZE is not set
+	defaultPreviewMaxSize = 20 * 1024 * 1024
+	// previewTextSize is how much of a text object is returned on its preview
+	previewTextSize = 64 * 1024
+	// previewContentSecurityPolicy keeps the browser from running anything coming from a bucket
+	previewContentSecurityPolicy = "default-src 'none'; sandbox"
+)
+
+// previewImageTypes are the detected content types previewed as images, SVG is left out on
+// purpose since it can hold scripts.
+var previewImageTypes = map[string]bool{
+	"image/png":    true,
+	"image/jpeg":   true,
+	"image/gif":    true,
+	"image/webp":   true,
+	"image/bmp":    true,
+	"image/x-icon": true,
+}
+
+func registerObjectPreviewHandlers(api *operations.ConsoleAPI) {
+	// preview object
+	api.UserAPIPreviewObjectHandler = user_api.PreviewObjectHandlerFunc(func(params user_api.PreviewObjectParams, session *models.Principal) middleware.Responder {
+		preview, image, err := getPreviewObjectResponse(session, params)
+		if err != nil {
+			return user_api.NewPreviewObjectDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		// Custom response writer, images are returned as they are and anything else as JSON
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			if err := writeObjectPreview(w, preview, image); err != nil {
+				log.Println("error writing object preview:", err)
+			}
+		})
+	})
+}
+
+// previewObject reads the beginning of the object to detect its content type. Images are
+// fully read and returned along with the preview, text objects get their first previewTextSize
+// bytes as content, pretty-printed if they hold a whole JSON document, and any other object
+// is only described. Objects bigger than maxSize are not previewed at all.
+func previewObject(ctx context.Context, client MinioClient, bucketName, objectName, versionID string, maxSize int64) (*models.ObjectPreview, []byte, error) {
+	object, info, err := openObject(ctx, client, bucketName, objectName, versionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer object.Close()
+	if info.Size > maxSize {
+		return nil, nil, errors.New(500, "error object is bigger than the %d bytes that can be previewed", maxSize)
+	}
+
+	head, err := ioutil.ReadAll(io.LimitReader(object, previewTextSize))
+	if err != nil {
+		return nil, nil, err
+	}
+	detected := http.DetectContentType(head)
+	preview := &models.ObjectPreview{
+		Name:                info.Key,
+		VersionID:           info.VersionID,
+		Size:                info.Size,
+		Etag:                info.ETag,
+		LastModified:        info.LastModified.Format(time.RFC3339),
+		ContentType:         info.ContentType,
+		DetectedContentType: detected,
+		Kind:                models.ObjectPreviewKindBinary,
+	}
+
+	mediaType := strings.TrimSpace(strings.Split(detected, ";")[0])
+	switch {
+	case previewImageTypes[mediaType]:
+		rest, err := ioutil.ReadAll(io.LimitReader(object, maxSize-int64(len(head))))
+		if err != nil {
+			return nil, nil, err
+		}
+		preview.Kind = models.ObjectPreviewKindImage
+		return preview, append(head, rest...), nil
+	case strings.HasPrefix(mediaType, "text/"):
+		text := head
+		truncated := info.Size > int64(len(head))
+		if truncated {
+			// the limit may have split the last character
+			for i := 0; i < utf8.UTFMax-1 && len(text) > 0 && !utf8.Valid(text); i++ {
+				text = text[:len(text)-1]
+			}
+		}
+		if !utf8.Valid(text) {
+			return preview, nil, nil
+		}
+		preview.Kind = models.ObjectPreviewKindText
+		preview.Truncated = truncated
+		if !truncated && json.Valid(text) {
+			indented := &bytes.Buffer{}
+			if err := json.Indent(indented, text, "", "  "); err == nil {
+				text = indented.Bytes()
+				preview.Kind = models.ObjectPreviewKindJSON
+			}
+		}
+		preview.Content = string(text)
+	}
+	return preview, nil, nil
+}
+
+// writeObjectPreview writes the image, or the preview as JSON, making sure the browser neither
+// sniffs the content type nor runs anything found on the response.
+func writeObjectPreview(w http.ResponseWriter, preview *models.ObjectPreview, image []byte) error {
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", previewContentSecurityPolicy)
+	if preview.Kind == models.ObjectPreviewKindImage {
+		w.Header().Set("Content-Type", preview.DetectedContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(image)))
+		_, err := w.Write(image)
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(preview)
+}
+
+// getPreviewObjectResponse performs previewObject() with the configured maximum size
+func getPreviewObjectResponse(session *models.Principal, params user_api.PreviewObjectParams) (*models.ObjectPreview, []byte, error) {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return nil, nil, errors.New(500, "error object name not in request")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	preview, image, err := previewObject(ctx, minioClient, params.BucketName, *params.Prefix, swag.StringValue(params.VersionID), getPreviewMaxSize())
+	if err != nil {
+		log.Println("error previewing object:", err)
+		return nil, nil, err
+	}
+	return preview, image, nil
+}
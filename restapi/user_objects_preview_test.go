@@ -0,0 +1,122 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewObject(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "previewObject()"
+
+	var content []byte
+	var gotOpts minio.GetObjectOptions
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error) {
+		gotOpts = opts
+		info := minio.ObjectInfo{Key: objectName, Size: int64(len(content)), ContentType: "application/octet-stream", LastModified: time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)}
+		return objectReaderMock{Reader: bytes.NewReader(content), info: info}, nil
+	}
+
+	// Test-1: previewObject() pretty-prints JSON documents
+	content = []byte(`{"level":"error","count":2}`)
+	preview, image, err := previewObject(ctx, minClient, "bucket1", "status.json", "v1", 1024)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("v1", gotOpts.VersionID)
+	assert.Nil(image)
+	assert.Equal(models.ObjectPreviewKindJSON, preview.Kind)
+	assert.Equal("{\n  \"level\": \"error\",\n  \"count\": 2\n}", preview.Content)
+	assert.Equal("application/octet-stream", preview.ContentType)
+	assert.Equal("2020-08-01T10:00:00Z", preview.LastModified)
+	assert.False(preview.Truncated)
+
+	// Test-2: previewObject() returns the beginning of big text objects
+	content = []byte(strings.Repeat("GET /index.html 200\n", previewTextSize/10))
+	preview, _, err = previewObject(ctx, minClient, "bucket1", "access.log", "", int64(len(content)))
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(models.ObjectPreviewKindText, preview.Kind)
+	assert.True(preview.Truncated)
+	assert.Equal(previewTextSize, len(preview.Content))
+
+	// Test-3: previewObject() returns HTML as text only
+	content = []byte("<html><script>alert(1)</script></html>")
+	preview, image, _ = previewObject(ctx, minClient, "bucket1", "index.html", "", 1024)
+	assert.Nil(image)
+	assert.Equal("text/html; charset=utf-8", preview.DetectedContentType)
+	assert.Equal(models.ObjectPreviewKindText, preview.Kind)
+	assert.Equal(string(content), preview.Content)
+
+	// Test-4: previewObject() returns the whole image detecting its type
+	content = append([]byte("\x89PNG\x0D\x0A\x1A\x0A"), make([]byte, previewTextSize)...)
+	preview, image, _ = previewObject(ctx, minClient, "bucket1", "logo.png", "", int64(len(content)))
+	assert.Equal(models.ObjectPreviewKindImage, preview.Kind)
+	assert.Equal("image/png", preview.DetectedContentType)
+	assert.Equal(content, image)
+
+	// Test-5: previewObject() only describes binary objects
+	content = []byte{0x00, 0x01, 0x02, 0xff}
+	preview, image, _ = previewObject(ctx, minClient, "bucket1", "data.bin", "", 1024)
+	assert.Nil(image)
+	assert.Equal(models.ObjectPreviewKindBinary, preview.Kind)
+	assert.Equal("", preview.Content)
+	assert.Equal(int64(4), preview.Size)
+
+	// Test-6: previewObject() refuses objects over the maximum size
+	content = []byte("too big")
+	if _, _, err = previewObject(ctx, minClient, "bucket1", "big.txt", "", 3); assert.Error(err) {
+		assert.Equal("error object is bigger than the 3 bytes that can be previewed", err.Error())
+	}
+}
+
+func TestWriteObjectPreview(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: writeObjectPreview() writes images with their detected type
+	rec := httptest.NewRecorder()
+	assert.NoError(writeObjectPreview(rec, &models.ObjectPreview{Kind: models.ObjectPreviewKindImage, DetectedContentType: "image/gif"}, []byte("GIF89a")))
+	assert.Equal("image/gif", rec.Header().Get("Content-Type"))
+	assert.Equal("nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Equal(previewContentSecurityPolicy, rec.Header().Get("Content-Security-Policy"))
+	assert.Equal("GIF89a", rec.Body.String())
+
+	// Test-2: writeObjectPreview() writes any other preview as JSON
+	rec = httptest.NewRecorder()
+	assert.NoError(writeObjectPreview(rec, &models.ObjectPreview{Kind: models.ObjectPreviewKindText, DetectedContentType: "text/html; charset=utf-8", Content: "<b>hi</b>"}, nil))
+	assert.Equal("application/json", rec.Header().Get("Content-Type"))
+	preview := &models.ObjectPreview{}
+	if assert.NoError(json.Unmarshal(rec.Body.Bytes(), preview)) {
+		assert.Equal("<b>hi</b>", preview.Content)
+	}
+}
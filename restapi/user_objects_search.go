@@ -0,0 +1,283 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio/pkg/wildcard"
+)
+
+const (
+	// defaultSearchObjectsLimit is the number of matches returned when no limit is requested
+	defaultSearchObjectsLimit = 1000
+	// maxSearchObjectsLimit caps the requested limit
+	maxSearchObjectsLimit = 10000
+	// searchObjectsConcurrency is the number of buckets listed at the same time
+	searchObjectsConcurrency = 4
+)
+
+// searchObjectsOptions are the filters of a search, every set filter must match
+type searchObjectsOptions struct {
+	// Buckets to search, every bucket the user can list if empty
+	Buckets []string
+	Prefix  string
+	// Name is matched as a case insensitive substring of the key
+	Name string
+	// Pattern is matched against the whole key, * and ? match slashes too
+	Pattern        string
+	MinSize        *int64
+	MaxSize        *int64
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+	// objects must have TagKey, with TagValue if it's not empty
+	TagKey   string
+	TagValue string
+	Limit    int
+}
+
+type searchObjectResult struct {
+	Bucket       string            `json:"bucket"`
+	Name         string            `json:"name"`
+	Size         int64             `json:"size"`
+	LastModified string            `json:"lastModified"`
+	Etag         string            `json:"etag"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// searchObjectsMessage is sent for every match, for every bucket that couldn't be searched
+// and once the search is over
+type searchObjectsMessage struct {
+	Object *searchObjectResult `json:"object,omitempty"`
+	Bucket string              `json:"bucket,omitempty"`
+	Error  string              `json:"error,omitempty"`
+	// Done is true on the last message along with the number of matches sent and
+	// whether there were more matches than the limit
+	Done      bool  `json:"done,omitempty"`
+	Matches   int64 `json:"matches,omitempty"`
+	Truncated bool  `json:"truncated,omitempty"`
+}
+
+// matchObject checks the filters that don't need another request
+func (opts *searchObjectsOptions) matchObject(object minio.ObjectInfo) bool {
+	if opts.Name != "" && !strings.Contains(strings.ToLower(object.Key), strings.ToLower(opts.Name)) {
+		return false
+	}
+	if opts.Pattern != "" && !wildcard.Match(opts.Pattern, object.Key) {
+		return false
+	}
+	if opts.MinSize != nil && object.Size < *opts.MinSize {
+		return false
+	}
+	if opts.MaxSize != nil && object.Size > *opts.MaxSize {
+		return false
+	}
+	if !opts.ModifiedAfter.IsZero() && object.LastModified.Before(opts.ModifiedAfter) {
+		return false
+	}
+	if !opts.ModifiedBefore.IsZero() && object.LastModified.After(opts.ModifiedBefore) {
+		return false
+	}
+	return true
+}
+
+// searchBucket lists the bucket sending every object matching the filters, tags are only
+// fetched for objects matching the rest of the filters. A bucket that can't be listed is
+// reported and doesn't stop the search.
+func searchBucket(ctx context.Context, client MinioClient, bucketName string, opts *searchObjectsOptions, messages chan<- searchObjectsMessage) {
+	send := func(message searchObjectsMessage) bool {
+		select {
+		case messages <- message:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	for object := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: opts.Prefix, Recursive: true}) {
+		if ctx.Err() != nil {
+			return
+		}
+		if object.Err != nil {
+			send(searchObjectsMessage{Bucket: bucketName, Error: object.Err.Error()})
+			return
+		}
+		if !opts.matchObject(object) {
+			continue
+		}
+		result := &searchObjectResult{
+			Bucket:       bucketName,
+			Name:         object.Key,
+			Size:         object.Size,
+			LastModified: object.LastModified.Format(time.RFC3339),
+			Etag:         object.ETag,
+		}
+		if opts.TagKey != "" {
+			objectTags, err := client.getObjectTagging(ctx, bucketName, object.Key, minio.GetObjectTaggingOptions{})
+			if err != nil {
+				log.Println("error getting object tags:", err)
+				continue
+			}
+			result.Tags = objectTags.ToMap()
+			value, ok := result.Tags[opts.TagKey]
+			if !ok || (opts.TagValue != "" && value != opts.TagValue) {
+				continue
+			}
+		}
+		if !send(searchObjectsMessage{Object: result}) {
+			return
+		}
+	}
+}
+
+// startSearchObjects searches the buckets concurrently streaming the matches through the
+// websocket connection, the search stops once the limit is exceeded or the client goes away.
+func startSearchObjects(ctx context.Context, conn WSConn, client MinioClient, opts *searchObjectsOptions) error {
+	// searchCtx stops the listings once the limit is exceeded
+	searchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	buckets := opts.Buckets
+	if len(buckets) == 0 {
+		bucketInfos, err := client.listBucketsWithContext(searchCtx)
+		if err != nil {
+			log.Println("error listing buckets:", err)
+			return err
+		}
+		for _, bucket := range bucketInfos {
+			buckets = append(buckets, bucket.Name)
+		}
+	}
+
+	messages := make(chan searchObjectsMessage)
+	bucketsCh := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < searchObjectsConcurrency && i < len(buckets); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for bucketName := range bucketsCh {
+				searchBucket(searchCtx, client, bucketName, opts, messages)
+			}
+		}()
+	}
+	go func() {
+		defer func() {
+			close(bucketsCh)
+			wg.Wait()
+			close(messages)
+		}()
+		for _, bucketName := range buckets {
+			select {
+			case bucketsCh <- bucketName:
+			case <-searchCtx.Done():
+				return
+			}
+		}
+	}()
+
+	sendMessage := func(message searchObjectsMessage) error {
+		bytes, err := json.Marshal(message)
+		if err != nil {
+			log.Println("error on json.Marshal:", err)
+			return err
+		}
+		// Send Message through websocket connection
+		if err := conn.writeMessage(websocket.TextMessage, bytes); err != nil {
+			log.Println("error writeMessage:", err)
+			return err
+		}
+		return nil
+	}
+	done := searchObjectsMessage{Done: true}
+	for message := range messages {
+		if message.Object != nil {
+			if done.Matches == int64(opts.Limit) {
+				done.Truncated = true
+				cancel()
+				break
+			}
+			done.Matches++
+		}
+		if err := sendMessage(message); err != nil {
+			return err
+		}
+	}
+	// nobody is listening if the client went away
+	if ctx.Err() != nil {
+		return nil
+	}
+	return sendMessage(done)
+}
+
+// getSearchObjectsOptionsFromReq gets the search filters from the websocket request
+// e.g. `/search?bucket=bucket1&bucket=bucket2&name=report&min_size=1024&tag_key=project`
+// sizes are in bytes and dates must be RFC3339 dates.
+func getSearchObjectsOptionsFromReq(req *http.Request) (*searchObjectsOptions, error) {
+	if err := req.ParseForm(); err != nil {
+		return nil, err
+	}
+	opts := searchObjectsOptions{
+		Buckets:  req.Form["bucket"],
+		Prefix:   req.FormValue("prefix"),
+		Name:     req.FormValue("name"),
+		Pattern:  req.FormValue("pattern"),
+		TagKey:   req.FormValue("tag_key"),
+		TagValue: req.FormValue("tag_value"),
+		Limit:    defaultSearchObjectsLimit,
+	}
+	if opts.TagValue != "" && opts.TagKey == "" {
+		return nil, errors.New("error tag_value requires tag_key")
+	}
+	for param, size := range map[string]**int64{"min_size": &opts.MinSize, "max_size": &opts.MaxSize} {
+		if value := req.FormValue(param); value != "" {
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || parsed < 0 {
+				return nil, errors.New("error invalid " + param)
+			}
+			*size = &parsed
+		}
+	}
+	for param, date := range map[string]*time.Time{"modified_after": &opts.ModifiedAfter, "modified_before": &opts.ModifiedBefore} {
+		if value := req.FormValue(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, errors.New("error invalid " + param + ", it must be a RFC3339 date")
+			}
+			*date = parsed
+		}
+	}
+	if value := req.FormValue("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return nil, errors.New("error invalid limit")
+		}
+		if limit > maxSearchObjectsLimit {
+			limit = maxSearchObjectsLimit
+		}
+		opts.Limit = limit
+	}
+	return &opts, nil
+}
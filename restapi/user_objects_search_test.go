@@ -0,0 +1,153 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchObjects(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	mockWSConn := mockConn{}
+	function := "startSearchObjects()"
+
+	lastModified := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	minioListBucketsWithContextMock = func(ctx context.Context) ([]minio.BucketInfo, error) {
+		return []minio.BucketInfo{{Name: "bucket1"}, {Name: "bucket2"}, {Name: "denied"}}, nil
+	}
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 3)
+		switch bucketName {
+		case "denied":
+			ch <- minio.ObjectInfo{Err: errors.New("Access Denied.")}
+		default:
+			ch <- minio.ObjectInfo{Key: "reports/2020/Q1-report.pdf", Size: 2048, LastModified: lastModified}
+			ch <- minio.ObjectInfo{Key: "reports/2020/notes.txt", Size: 10, LastModified: lastModified}
+			ch <- minio.ObjectInfo{Key: "photos/report.jpg", Size: 4096, LastModified: lastModified.Add(48 * time.Hour)}
+		}
+		close(ch)
+		return ch
+	}
+	var messages []searchObjectsMessage
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		var message searchObjectsMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			return err
+		}
+		messages = append(messages, message)
+		return nil
+	}
+	found := func() map[string]bool {
+		result := map[string]bool{}
+		for _, message := range messages {
+			if message.Object != nil {
+				result[message.Object.Bucket+"/"+message.Object.Name] = true
+			}
+		}
+		return result
+	}
+
+	// Test-1: startSearchObjects() searches every bucket reporting the ones that can't be listed
+	opts := &searchObjectsOptions{Name: "REPORT.", MaxSize: swag.Int64(3000), Limit: 10}
+	if err := startSearchObjects(ctx, mockWSConn, minClient, opts); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(map[string]bool{"bucket1/reports/2020/Q1-report.pdf": true, "bucket2/reports/2020/Q1-report.pdf": true}, found())
+	last := messages[len(messages)-1]
+	assert.Equal(searchObjectsMessage{Done: true, Matches: 2}, last)
+	assert.Contains(messages, searchObjectsMessage{Bucket: "denied", Error: "Access Denied."})
+
+	// Test-2: startSearchObjects() stops once the limit is exceeded
+	messages = nil
+	opts = &searchObjectsOptions{Buckets: []string{"bucket1"}, Limit: 2}
+	if err := startSearchObjects(ctx, mockWSConn, minClient, opts); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	if assert.Equal(3, len(messages), fmt.Sprintf("Failed on %s: number of messages is not the same", function)) {
+		assert.Equal(searchObjectsMessage{Done: true, Matches: 2, Truncated: true}, messages[2])
+	}
+
+	// Test-3: startSearchObjects() filters by glob, date and tag
+	minioGetObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+		if objectName == "photos/report.jpg" {
+			return tags.MapToObjectTags(map[string]string{"project": "apollo"})
+		}
+		return tags.MapToObjectTags(map[string]string{})
+	}
+	messages = nil
+	opts = &searchObjectsOptions{Buckets: []string{"bucket1"}, Pattern: "*.jpg", ModifiedAfter: lastModified.Add(time.Hour), TagKey: "project", TagValue: "apollo", Limit: 10}
+	if err := startSearchObjects(ctx, mockWSConn, minClient, opts); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	if assert.Equal(2, len(messages)) {
+		assert.Equal("photos/report.jpg", messages[0].Object.Name)
+		assert.Equal(map[string]string{"project": "apollo"}, messages[0].Object.Tags)
+		assert.Equal("2020-08-03T10:00:00Z", messages[0].Object.LastModified)
+	}
+
+	// Test-4: startSearchObjects() handles errors listing buckets
+	minioListBucketsWithContextMock = func(ctx context.Context) ([]minio.BucketInfo, error) {
+		return nil, errors.New("error")
+	}
+	if err := startSearchObjects(ctx, mockWSConn, minClient, &searchObjectsOptions{Limit: 10}); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestGetSearchObjectsOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+	function := "getSearchObjectsOptionsFromReq()"
+
+	// Test-1: getSearchObjectsOptionsFromReq() returns the filters
+	u, _ := url.Parse("http://localhost/ws/search?bucket=bucket1&bucket=bucket2&name=report&min_size=1024&modified_before=2020-08-01T00:00:00Z&tag_key=project&limit=50000")
+	opts, err := getSearchObjectsOptionsFromReq(&http.Request{URL: u})
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]string{"bucket1", "bucket2"}, opts.Buckets)
+	assert.Equal("report", opts.Name)
+	assert.Equal(int64(1024), *opts.MinSize)
+	assert.Nil(opts.MaxSize)
+	assert.Equal(time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC), opts.ModifiedBefore)
+	assert.Equal("project", opts.TagKey)
+	assert.Equal(maxSearchObjectsLimit, opts.Limit)
+
+	// Test-2: getSearchObjectsOptionsFromReq() rejects invalid filters
+	for _, query := range []string{"max_size=big", "modified_after=yesterday", "limit=0", "tag_value=apollo"} {
+		u, _ = url.Parse("http://localhost/ws/search?" + query)
+		_, err = getSearchObjectsOptionsFromReq(&http.Request{URL: u})
+		assert.Error(err, query)
+	}
+}
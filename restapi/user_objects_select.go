@@ -0,0 +1,308 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// defaultSelectRowLimit is the number of records returned when row_limit is not present on the request
+	defaultSelectRowLimit = 1000
+	// maxSelectRowLimit keeps a query from streaming a whole object back to the browser
+	maxSelectRowLimit = 100000
+	// selectReadSize is the size of the chunks read from the query result
+	selectReadSize = 64 * 1024
+	// selectRecordDelimiter separates the returned records, for both CSV and JSON output
+	selectRecordDelimiter = "\n"
+	// selectCSVQuoteCharacter is the quote character of the CSV records returned by MinIO
+	selectCSVQuoteCharacter = '"'
+)
+
+func registerObjectSelectHandlers(api *operations.ConsoleAPI) {
+	// run an S3 Select query over an object
+	api.UserAPISelectObjectContentHandler = user_api.SelectObjectContentHandlerFunc(func(params user_api.SelectObjectContentParams, session *models.Principal) middleware.Responder {
+		results, rowLimit, err := getSelectObjectContentResponse(session, params)
+		if err != nil {
+			return user_api.NewSelectObjectContentDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		// Custom response writer, the records are sent while MinIO scans the object so
+		// errors found after this point are reported as error events
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			csvRecords := params.Body.OutputFormat == models.SelectObjectRequestOutputFormatCsv
+			if err := streamSelectResults(results, rowLimit, csvRecords, params.Body.RequestProgress, w); err != nil {
+				log.Println("error streaming select results:", err)
+			}
+			if err := results.Close(); err != nil {
+				log.Println(err)
+			}
+		})
+	})
+}
+
+// selectObjectOptions translates the request into the options of the S3 Select API, records are
+// always returned one per line so they can be counted against the row limit.
+func selectObjectOptions(req *models.SelectObjectRequest) (minio.SelectObjectOptions, error) {
+	opts := minio.SelectObjectOptions{
+		Expression:     *req.Expression,
+		ExpressionType: minio.QueryExpressionTypeSQL,
+	}
+	opts.RequestProgress.Enabled = req.RequestProgress
+
+	input := req.Input
+	switch input.Compression {
+	case "", models.SelectInputSerializationCompressionNone:
+		opts.InputSerialization.CompressionType = minio.SelectCompressionNONE
+	case models.SelectInputSerializationCompressionGzip:
+		opts.InputSerialization.CompressionType = minio.SelectCompressionGZIP
+	case models.SelectInputSerializationCompressionBzip2:
+		opts.InputSerialization.CompressionType = minio.SelectCompressionBZIP
+	}
+	switch *input.Format {
+	case models.SelectInputSerializationFormatCsv:
+		csvOpts := &minio.CSVInputOptions{}
+		switch input.CsvHeader {
+		case "", models.SelectInputSerializationCsvHeaderUse:
+			csvOpts.SetFileHeaderInfo(minio.CSVFileHeaderInfoUse)
+		case models.SelectInputSerializationCsvHeaderIgnore:
+			csvOpts.SetFileHeaderInfo(minio.CSVFileHeaderInfoIgnore)
+		case models.SelectInputSerializationCsvHeaderNone:
+			csvOpts.SetFileHeaderInfo(minio.CSVFileHeaderInfoNone)
+		}
+		if input.CsvFieldDelimiter != "" {
+			csvOpts.SetFieldDelimiter(input.CsvFieldDelimiter)
+		}
+		if input.CsvQuoteCharacter != "" {
+			csvOpts.SetQuoteCharacter(input.CsvQuoteCharacter)
+		}
+		if input.CsvComments != "" {
+			csvOpts.SetComments(input.CsvComments)
+		}
+		opts.InputSerialization.CSV = csvOpts
+	case models.SelectInputSerializationFormatJSON:
+		jsonOpts := &minio.JSONInputOptions{}
+		if input.JSONType == models.SelectInputSerializationJSONTypeDocument {
+			jsonOpts.SetType(minio.JSONDocumentType)
+		} else {
+			jsonOpts.SetType(minio.JSONLinesType)
+		}
+		opts.InputSerialization.JSON = jsonOpts
+	case models.SelectInputSerializationFormatParquet:
+		// parquet objects are compressed by columns, S3 Select doesn't accept a compression type for them
+		if opts.InputSerialization.CompressionType != minio.SelectCompressionNONE {
+			return minio.SelectObjectOptions{}, errors.New(500, "error compression is not supported for parquet objects")
+		}
+		opts.InputSerialization.Parquet = &minio.ParquetInputOptions{}
+	}
+
+	if req.OutputFormat == models.SelectObjectRequestOutputFormatCsv {
+		csvOpts := &minio.CSVOutputOptions{}
+		csvOpts.SetRecordDelimiter(selectRecordDelimiter)
+		opts.OutputSerialization.CSV = csvOpts
+	} else {
+		jsonOpts := &minio.JSONOutputOptions{}
+		jsonOpts.SetRecordDelimiter(selectRecordDelimiter)
+		opts.OutputSerialization.JSON = jsonOpts
+	}
+	return opts, nil
+}
+
+// selectRowLimit returns the row limit of the request, or the default one if not set
+func selectRowLimit(req *models.SelectObjectRequest) (int64, error) {
+	if req.RowLimit == 0 {
+		return defaultSelectRowLimit, nil
+	}
+	if req.RowLimit > maxSelectRowLimit {
+		return 0, errors.New(500, "error row_limit can't be greater than %d", maxSelectRowLimit)
+	}
+	return req.RowLimit, nil
+}
+
+// selectEventWriter writes each event as a JSON line flushing it right away, so the client
+// receives the records while the object is still being scanned.
+type selectEventWriter struct {
+	encoder *json.Encoder
+	flusher http.Flusher
+}
+
+func newSelectEventWriter(w io.Writer) *selectEventWriter {
+	flusher, _ := w.(http.Flusher)
+	return &selectEventWriter{encoder: json.NewEncoder(w), flusher: flusher}
+}
+
+func (sw *selectEventWriter) write(event *models.SelectObjectEvent) error {
+	if err := sw.encoder.Encode(event); err != nil {
+		return err
+	}
+	if sw.flusher != nil {
+		sw.flusher.Flush()
+	}
+	return nil
+}
+
+// selectRecordEnd returns the length of the first complete record of data, delimiter included,
+// or -1 if the record isn't complete yet. Quoted CSV fields can hold the delimiter so quotes are
+// tracked for CSV records, JSON output escapes it inside strings.
+func selectRecordEnd(data []byte, csvRecords bool) int {
+	delimiter := []byte(selectRecordDelimiter)
+	if !csvRecords {
+		i := bytes.Index(data, delimiter)
+		if i < 0 {
+			return -1
+		}
+		return i + len(delimiter)
+	}
+	inQuotes := false
+	for i, c := range data {
+		if c == selectCSVQuoteCharacter {
+			// escaped quotes are doubled so they toggle the state twice
+			inQuotes = !inQuotes
+		} else if !inQuotes && bytes.HasPrefix(data[i:], delimiter) {
+			return i + len(delimiter)
+		}
+	}
+	return -1
+}
+
+// streamSelectResults sends the records of the query as events holding whole records only, once
+// rowLimit records were sent the query is stopped. Progress events are sent whenever MinIO reports
+// progress and a stats event always closes the stream unless the client went away.
+func streamSelectResults(results selectResults, rowLimit int64, csvRecords bool, withProgress bool, w io.Writer) error {
+	events := newSelectEventWriter(w)
+	buf := make([]byte, selectReadSize)
+	delimiter := []byte(selectRecordDelimiter)
+	var pending []byte
+	var rows int64
+	var lastScanned int64
+	truncated := false
+
+	for {
+		n, readErr := results.Read(buf)
+		pending = append(pending, buf[:n]...)
+		if readErr == io.EOF && len(pending) > 0 && !bytes.HasSuffix(pending, delimiter) {
+			// the last record isn't followed by a delimiter
+			pending = append(pending, delimiter...)
+		}
+		// only complete records are sent, the rest waits for the next read
+		end := 0
+		for end < len(pending) {
+			i := selectRecordEnd(pending[end:], csvRecords)
+			if i < 0 {
+				if readErr != io.EOF {
+					break
+				}
+				// an unterminated quote at the end of the results closes the last record
+				i = len(pending) - end
+			}
+			end += i
+			rows++
+			if rows == rowLimit {
+				truncated = readErr != io.EOF || end < len(pending)
+				break
+			}
+		}
+		if end > 0 {
+			if err := events.write(&models.SelectObjectEvent{Type: models.SelectObjectEventTypeRecords, Records: string(pending[:end])}); err != nil {
+				return err
+			}
+			pending = pending[end:]
+		}
+		if rows == rowLimit {
+			break
+		}
+		if withProgress {
+			if progress := results.Progress(); progress != nil && progress.BytesScanned != lastScanned {
+				lastScanned = progress.BytesScanned
+				if err := events.write(&models.SelectObjectEvent{
+					Type:           models.SelectObjectEventTypeProgress,
+					BytesScanned:   progress.BytesScanned,
+					BytesProcessed: progress.BytesProcessed,
+					BytesReturned:  progress.BytesReturned,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			if err := events.write(&models.SelectObjectEvent{Type: models.SelectObjectEventTypeError, Error: readErr.Error()}); err != nil {
+				return err
+			}
+			return readErr
+		}
+	}
+
+	statsEvent := &models.SelectObjectEvent{Type: models.SelectObjectEventTypeStats, Truncated: truncated}
+	if stats := results.Stats(); stats != nil {
+		statsEvent.BytesScanned = stats.BytesScanned
+		statsEvent.BytesProcessed = stats.BytesProcessed
+		statsEvent.BytesReturned = stats.BytesReturned
+	}
+	return events.write(statsEvent)
+}
+
+// getSelectObjectContentResponse starts the query, MinIO validates the expression and the
+// serialization before answering so those errors are returned before anything is streamed.
+func getSelectObjectContentResponse(session *models.Principal, params user_api.SelectObjectContentParams) (selectResults, int64, error) {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return nil, 0, errors.New(500, "error object name not in request")
+	}
+	opts, err := selectObjectOptions(params.Body)
+	if err != nil {
+		log.Println("error building select options:", err)
+		return nil, 0, err
+	}
+	rowLimit, err := selectRowLimit(params.Body)
+	if err != nil {
+		log.Println("error validating row limit:", err)
+		return nil, 0, err
+	}
+	// the results are read while the response is written so the request context is used
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, 0, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	results, err := minioClient.selectObjectContent(ctx, params.BucketName, *params.Prefix, opts)
+	if err != nil {
+		log.Println("error selecting object content:", err)
+		return nil, 0, err
+	}
+	return results, rowLimit, nil
+}
@@ -0,0 +1,219 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioSelectObjectContentMock func(ctx context.Context, bucketName, objectName string, opts minio.SelectObjectOptions) (selectResults, error)
+
+// mock function of selectObjectContent()
+func (mc minioClientMock) selectObjectContent(ctx context.Context, bucketName, objectName string, opts minio.SelectObjectOptions) (selectResults, error) {
+	return minioSelectObjectContentMock(ctx, bucketName, objectName, opts)
+}
+
+// selectResultsMock returns its records in chunks of the given size
+type selectResultsMock struct {
+	reader    io.Reader
+	chunkSize int
+	err       error
+	stats     *minio.StatsMessage
+	progress  *minio.ProgressMessage
+}
+
+func (sr *selectResultsMock) Read(b []byte) (int, error) {
+	if len(b) > sr.chunkSize {
+		b = b[:sr.chunkSize]
+	}
+	n, err := sr.reader.Read(b)
+	if err == io.EOF && sr.err != nil {
+		return n, sr.err
+	}
+	if sr.progress != nil {
+		sr.progress.BytesScanned += int64(n)
+	}
+	return n, err
+}
+
+func (sr *selectResultsMock) Close() error {
+	return nil
+}
+
+func (sr *selectResultsMock) Stats() *minio.StatsMessage {
+	return sr.stats
+}
+
+func (sr *selectResultsMock) Progress() *minio.ProgressMessage {
+	return sr.progress
+}
+
+// decodeSelectEvents reads back the events written by streamSelectResults()
+func decodeSelectEvents(t *testing.T, out *bytes.Buffer) []*models.SelectObjectEvent {
+	var events []*models.SelectObjectEvent
+	decoder := json.NewDecoder(out)
+	for decoder.More() {
+		event := &models.SelectObjectEvent{}
+		if err := decoder.Decode(event); err != nil {
+			t.Fatalf("error decoding select event: %s", err.Error())
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestSelectObjectOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: selectObjectOptions() defaults to a CSV header and JSON output
+	opts, err := selectObjectOptions(&models.SelectObjectRequest{
+		Expression: swag.String("SELECT * FROM S3Object s WHERE s.status = '500'"),
+		Input:      &models.SelectInputSerialization{Format: swag.String(models.SelectInputSerializationFormatCsv), Compression: models.SelectInputSerializationCompressionGzip, CsvFieldDelimiter: ";"},
+	})
+	if assert.NoError(err) {
+		assert.Equal(minio.QueryExpressionTypeSQL, opts.ExpressionType)
+		assert.Equal(minio.SelectCompressionType(minio.SelectCompressionGZIP), opts.InputSerialization.CompressionType)
+		assert.Equal(minio.CSVFileHeaderInfo(minio.CSVFileHeaderInfoUse), opts.InputSerialization.CSV.FileHeaderInfo)
+		assert.Equal(";", opts.InputSerialization.CSV.FieldDelimiter)
+		assert.Nil(opts.OutputSerialization.CSV)
+		assert.Equal("\n", opts.OutputSerialization.JSON.RecordDelimiter)
+	}
+
+	// Test-2: selectObjectOptions() reads JSON lines and returns CSV
+	opts, err = selectObjectOptions(&models.SelectObjectRequest{
+		Expression:      swag.String("SELECT * FROM S3Object"),
+		Input:           &models.SelectInputSerialization{Format: swag.String(models.SelectInputSerializationFormatJSON)},
+		OutputFormat:    models.SelectObjectRequestOutputFormatCsv,
+		RequestProgress: true,
+	})
+	if assert.NoError(err) {
+		assert.Equal(minio.JSONType(minio.JSONLinesType), opts.InputSerialization.JSON.Type)
+		assert.Equal("\n", opts.OutputSerialization.CSV.RecordDelimiter)
+		assert.True(opts.RequestProgress.Enabled)
+	}
+
+	// Test-3: selectObjectOptions() rejects compressed parquet objects
+	_, err = selectObjectOptions(&models.SelectObjectRequest{
+		Expression: swag.String("SELECT * FROM S3Object"),
+		Input:      &models.SelectInputSerialization{Format: swag.String(models.SelectInputSerializationFormatParquet), Compression: models.SelectInputSerializationCompressionBzip2},
+	})
+	if assert.Error(err) {
+		assert.Equal("error compression is not supported for parquet objects", err.Error())
+	}
+
+	// Test-4: selectRowLimit() applies the default and the maximum
+	rowLimit, err := selectRowLimit(&models.SelectObjectRequest{})
+	assert.NoError(err)
+	assert.Equal(int64(defaultSelectRowLimit), rowLimit)
+	_, err = selectRowLimit(&models.SelectObjectRequest{RowLimit: maxSelectRowLimit + 1})
+	assert.Error(err)
+}
+
+func TestStreamSelectResults(t *testing.T) {
+	assert := assert.New(t)
+	function := "streamSelectResults()"
+
+	// Test-1: streamSelectResults() sends whole records followed by the stats
+	results := &selectResultsMock{
+		reader:    strings.NewReader("{\"a\":1}\n{\"a\":2}\n{\"a\":3}"),
+		chunkSize: 5,
+		stats:     &minio.StatsMessage{BytesScanned: 100, BytesProcessed: 100, BytesReturned: 23},
+	}
+	out := &bytes.Buffer{}
+	if err := streamSelectResults(results, 10, false, false, out); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	events := decodeSelectEvents(t, out)
+	var records string
+	for _, event := range events[:len(events)-1] {
+		assert.Equal(models.SelectObjectEventTypeRecords, event.Type)
+		assert.True(strings.HasSuffix(event.Records, "\n"))
+		records += event.Records
+	}
+	assert.Equal("{\"a\":1}\n{\"a\":2}\n{\"a\":3}\n", records)
+	stats := events[len(events)-1]
+	assert.Equal(models.SelectObjectEventTypeStats, stats.Type)
+	assert.Equal(int64(100), stats.BytesScanned)
+	assert.Equal(int64(23), stats.BytesReturned)
+	assert.False(stats.Truncated)
+
+	// Test-2: streamSelectResults() stops at the row limit and sends progress events
+	results = &selectResultsMock{
+		reader:    strings.NewReader("a,1\nb,2\nc,3\nd,4\n"),
+		chunkSize: 8,
+		stats:     &minio.StatsMessage{},
+		progress:  &minio.ProgressMessage{},
+	}
+	out = &bytes.Buffer{}
+	if err := streamSelectResults(results, 3, true, true, out); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	events = decodeSelectEvents(t, out)
+	if assert.Equal(4, len(events)) {
+		assert.Equal("a,1\nb,2\n", events[0].Records)
+		assert.Equal(models.SelectObjectEventTypeProgress, events[1].Type)
+		assert.Equal(int64(8), events[1].BytesScanned)
+		assert.Equal("c,3\n", events[2].Records)
+		assert.True(events[3].Truncated)
+	}
+
+	// Test-3: streamSelectResults() reports errors found while reading as an event
+	results = &selectResultsMock{
+		reader:    strings.NewReader("a,1\n"),
+		chunkSize: 64,
+		err:       errors.New("error"),
+	}
+	out = &bytes.Buffer{}
+	if err := streamSelectResults(results, 3, true, false, out); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+	events = decodeSelectEvents(t, out)
+	if assert.Equal(2, len(events)) {
+		assert.Equal("a,1\n", events[0].Records)
+		assert.Equal(models.SelectObjectEventTypeError, events[1].Type)
+		assert.Equal("error", events[1].Error)
+	}
+
+	// Test-4: streamSelectResults() counts CSV records holding quoted line breaks as one row
+	results = &selectResultsMock{
+		reader:    strings.NewReader("\"a\nb\",1\n\"c \"\"d\"\"\",2\ne,3\n"),
+		chunkSize: 4,
+		stats:     &minio.StatsMessage{},
+	}
+	out = &bytes.Buffer{}
+	if err := streamSelectResults(results, 2, true, false, out); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	events = decodeSelectEvents(t, out)
+	records = ""
+	for _, event := range events[:len(events)-1] {
+		records += event.Records
+	}
+	assert.Equal("\"a\nb\",1\n\"c \"\"d\"\"\",2\n", records)
+	assert.True(events[len(events)-1].Truncated)
+}
@@ -0,0 +1,242 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/console/restapi/operations/user_api"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+)
+
+// maxShareExpiry is the longest expiration accepted by S3 presigned URLs
+const maxShareExpiry = 7 * 24 * time.Hour
+
+// shareResponseHeaders are the response headers that can be overridden on a presigned GET URL
+var shareResponseHeaders = map[string]string{
+	"cache-control":       "response-cache-control",
+	"content-disposition": "response-content-disposition",
+	"content-encoding":    "response-content-encoding",
+	"content-language":    "response-content-language",
+	"content-type":        "response-content-type",
+	"expires":             "response-expires",
+}
+
+// shareLink is a presigned URL generated through the console
+type shareLink struct {
+	ID         string
+	BucketName string
+	ObjectName string
+	Method     string
+	CreatedBy  string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+}
+
+// shareLinksRegistry keeps in memory the links generated since the console started
+type shareLinksRegistry struct {
+	sync.Mutex
+	links []shareLink
+}
+
+// globalShareLinks records every link generated by ShareObject
+var globalShareLinks = &shareLinksRegistry{}
+
+// add records a new link and drops the ones that already expired
+func (r *shareLinksRegistry) add(link shareLink) {
+	r.Lock()
+	defer r.Unlock()
+	r.links = append(r.activeLinks(link.CreatedAt), link)
+}
+
+// list returns the links that haven't expired sorted by expiration
+func (r *shareLinksRegistry) list(now time.Time) []shareLink {
+	r.Lock()
+	defer r.Unlock()
+	r.links = r.activeLinks(now)
+	links := make([]shareLink, len(r.links))
+	copy(links, r.links)
+	sort.SliceStable(links, func(i, j int) bool {
+		return links[i].ExpiresAt.Before(links[j].ExpiresAt)
+	})
+	return links
+}
+
+func (r *shareLinksRegistry) activeLinks(now time.Time) []shareLink {
+	var active []shareLink
+	for _, link := range r.links {
+		if link.ExpiresAt.After(now) {
+			active = append(active, link)
+		}
+	}
+	return active
+}
+
+func registerShareHandlers(api *operations.ConsoleAPI) {
+	// share object
+	api.UserAPIShareObjectHandler = user_api.ShareObjectHandlerFunc(func(params user_api.ShareObjectParams, session *models.Principal) middleware.Responder {
+		shareObjectResponse, err := getShareObjectResponse(session, params)
+		if err != nil {
+			return user_api.NewShareObjectDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewShareObjectCreated().WithPayload(shareObjectResponse)
+	})
+	// list share links
+	api.AdminAPIListSharesHandler = admin_api.ListSharesHandlerFunc(func(params admin_api.ListSharesParams, session *models.Principal) middleware.Responder {
+		// links are only listed to administrators since they include every user's shares
+		if !isAdminSession(session) {
+			return admin_api.NewListSharesDefault(403).WithPayload(&models.Error{Code: 403, Message: swag.String("access denied")})
+		}
+		return admin_api.NewListSharesOK().WithPayload(getListSharesResponse(globalShareLinks, time.Now()))
+	})
+}
+
+// isAdminSession returns true if the session's policy allows every admin action
+func isAdminSession(session *models.Principal) bool {
+	for _, action := range session.Actions {
+		if action == string(iampolicy.AllAdminActions) {
+			return true
+		}
+	}
+	return false
+}
+
+// getShareResponseParams converts the requested header overrides to presigned URL query parameters
+func getShareResponseParams(headers map[string]string) (url.Values, error) {
+	reqParams := make(url.Values)
+	for header, value := range headers {
+		param, ok := shareResponseHeaders[strings.ToLower(header)]
+		if !ok {
+			return nil, errors.New(500, "response header %s cannot be overridden", header)
+		}
+		reqParams.Set(param, value)
+	}
+	return reqParams, nil
+}
+
+// shareObject generates a presigned URL for an object and records it on the registry
+func shareObject(ctx context.Context, client MinioClient, registry *shareLinksRegistry, createdBy, bucketName string, req *models.ShareObjectRequest) (*models.ShareObjectResponse, error) {
+	objectName := *req.Prefix
+	if objectName == "" {
+		return nil, errors.New(500, "error object name not in request")
+	}
+	expiry := maxShareExpiry
+	if req.Expires > 0 {
+		expiry = time.Duration(req.Expires) * time.Second
+	}
+	if expiry > maxShareExpiry {
+		return nil, errors.New(500, "error links can't expire after 7 days")
+	}
+	method := req.Method
+	if method == "" {
+		method = models.ShareObjectRequestMethodGET
+	}
+	var presignedURL *url.URL
+	var err error
+	switch method {
+	case models.ShareObjectRequestMethodGET:
+		var reqParams url.Values
+		reqParams, err = getShareResponseParams(req.ResponseHeaders)
+		if err != nil {
+			return nil, err
+		}
+		presignedURL, err = client.presignedGetObject(ctx, bucketName, objectName, expiry, reqParams)
+	case models.ShareObjectRequestMethodPUT:
+		if len(req.ResponseHeaders) > 0 {
+			return nil, errors.New(500, "error response headers can only be set on GET links")
+		}
+		presignedURL, err = client.presignedPutObject(ctx, bucketName, objectName, expiry)
+	default:
+		return nil, errors.New(500, "error method %s not supported", method)
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	link := shareLink{
+		ID:         RandomCharString(16),
+		BucketName: bucketName,
+		ObjectName: objectName,
+		Method:     method,
+		CreatedBy:  createdBy,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(expiry),
+	}
+	registry.add(link)
+	return &models.ShareObjectResponse{
+		URL:       presignedURL.String(),
+		Method:    method,
+		ExpiresAt: link.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// getShareObjectResponse generates a presigned URL signed with the session credentials
+func getShareObjectResponse(session *models.Principal, params user_api.ShareObjectParams) (*models.ShareObjectResponse, error) {
+	ctx := context.Background()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	// session keys are temporary, links are recorded under the account that shared them
+	account, err := getSessionAccountName(session)
+	if err != nil {
+		return nil, err
+	}
+	shareResponse, err := shareObject(ctx, minioClient, globalShareLinks, account, params.BucketName, params.Body)
+	if err != nil {
+		log.Println("error sharing object:", err)
+		return nil, err
+	}
+	return shareResponse, nil
+}
+
+// getListSharesResponse returns the links of the registry that haven't expired
+func getListSharesResponse(registry *shareLinksRegistry, now time.Time) *models.ListSharesResponse {
+	shares := []*models.ShareLink{}
+	for _, link := range registry.list(now) {
+		shares = append(shares, &models.ShareLink{
+			ID:        link.ID,
+			Bucket:    link.BucketName,
+			Object:    link.ObjectName,
+			Method:    link.Method,
+			CreatedBy: link.CreatedBy,
+			CreatedAt: link.CreatedAt.Format(time.RFC3339),
+			ExpiresAt: link.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+	return &models.ListSharesResponse{
+		Shares: shares,
+		Total:  int64(len(shares)),
+	}
+}
@@ -0,0 +1,131 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioPresignedGetObjectMock func(ctx context.Context, bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error)
+
+var minioPresignedPutObjectMock func(ctx context.Context, bucketName, objectName string, expiry time.Duration) (*url.URL, error)
+
+// mock function of presignedGetObject()
+func (mc minioClientMock) presignedGetObject(ctx context.Context, bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error) {
+	return minioPresignedGetObjectMock(ctx, bucketName, objectName, expiry, reqParams)
+}
+
+// mock function of presignedPutObject()
+func (mc minioClientMock) presignedPutObject(ctx context.Context, bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+	return minioPresignedPutObjectMock(ctx, bucketName, objectName, expiry)
+}
+
+func TestShareObject(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	registry := &shareLinksRegistry{}
+	function := "shareObject()"
+
+	var gotExpiry time.Duration
+	var gotParams url.Values
+	minioPresignedGetObjectMock = func(ctx context.Context, bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error) {
+		gotExpiry = expiry
+		gotParams = reqParams
+		return url.Parse(fmt.Sprintf("http://localhost:9000/%s/%s?X-Amz-Signature=get", bucketName, objectName))
+	}
+	minioPresignedPutObjectMock = func(ctx context.Context, bucketName, objectName string, expiry time.Duration) (*url.URL, error) {
+		gotExpiry = expiry
+		return url.Parse(fmt.Sprintf("http://localhost:9000/%s/%s?X-Amz-Signature=put", bucketName, objectName))
+	}
+
+	// Test-1: shareObject() generates a GET link with the default expiry and header overrides
+	req := &models.ShareObjectRequest{
+		Prefix:          swag.String("photos/a.jpg"),
+		ResponseHeaders: map[string]string{"Content-Disposition": "attachment; filename=\"a.jpg\""},
+	}
+	shareResponse, err := shareObject(ctx, minClient, registry, "user1", "bucket1", req)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("http://localhost:9000/bucket1/photos/a.jpg?X-Amz-Signature=get", shareResponse.URL)
+	assert.Equal("GET", shareResponse.Method)
+	assert.Equal(maxShareExpiry, gotExpiry)
+	assert.Equal("attachment; filename=\"a.jpg\"", gotParams.Get("response-content-disposition"))
+
+	// Test-2: shareObject() generates a PUT link with the requested expiry
+	req = &models.ShareObjectRequest{Prefix: swag.String("upload.txt"), Method: "PUT", Expires: 3600}
+	shareResponse, err = shareObject(ctx, minClient, registry, "user2", "bucket1", req)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal("PUT", shareResponse.Method)
+	assert.Equal(time.Hour, gotExpiry)
+
+	// Test-3: shareObject() rejects header overrides not supported by S3
+	req = &models.ShareObjectRequest{Prefix: swag.String("a.jpg"), ResponseHeaders: map[string]string{"x-custom": "value"}}
+	if _, err = shareObject(ctx, minClient, registry, "user1", "bucket1", req); assert.Error(err) {
+		assert.Equal("response header x-custom cannot be overridden", err.Error())
+	}
+
+	// Test-4: shareObject() rejects expirations over 7 days
+	req = &models.ShareObjectRequest{Prefix: swag.String("a.jpg"), Expires: 604801}
+	if _, err = shareObject(ctx, minClient, registry, "user1", "bucket1", req); assert.Error(err) {
+		assert.Equal("error links can't expire after 7 days", err.Error())
+	}
+
+	// Test-5: shareObject() returns presign errors and doesn't record the link
+	minioPresignedGetObjectMock = func(ctx context.Context, bucketName, objectName string, expiry time.Duration, reqParams url.Values) (*url.URL, error) {
+		return nil, errors.New("Bucket name cannot be empty")
+	}
+	req = &models.ShareObjectRequest{Prefix: swag.String("a.jpg")}
+	if _, err = shareObject(ctx, minClient, registry, "user1", "", req); assert.Error(err) {
+		assert.Equal("Bucket name cannot be empty", err.Error())
+	}
+
+	// Test-6: getListSharesResponse() lists the active links sorted by expiration
+	function = "getListSharesResponse()"
+	sharesResponse := getListSharesResponse(registry, time.Now())
+	if assert.Equal(int64(2), sharesResponse.Total, fmt.Sprintf("Failed on %s: number of shares is not the same", function)) {
+		assert.Equal("upload.txt", sharesResponse.Shares[0].Object)
+		assert.Equal("user2", sharesResponse.Shares[0].CreatedBy)
+		assert.Equal("photos/a.jpg", sharesResponse.Shares[1].Object)
+		assert.Equal("bucket1", sharesResponse.Shares[1].Bucket)
+	}
+
+	// Test-7: getListSharesResponse() drops expired links
+	sharesResponse = getListSharesResponse(registry, time.Now().Add(2*time.Hour))
+	assert.Equal(int64(1), sharesResponse.Total)
+	assert.Equal("photos/a.jpg", sharesResponse.Shares[0].Object)
+}
+
+func TestIsAdminSession(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(isAdminSession(&models.Principal{Actions: []string{"s3:*", "admin:*"}}))
+	assert.False(isAdminSession(&models.Principal{Actions: []string{"s3:*", "admin:ServerInfo"}}))
+}
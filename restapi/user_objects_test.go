@@ -0,0 +1,433 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioListObjectsV2Mock func(ctx context.Context, bucketName, prefix, continuationToken, delimiter string, maxKeys int) (minio.ListBucketV2Result, error)
+
+var minioPutObjectMock func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+
+var minioGetObjectMock func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error)
+
+var minioListObjectsMock func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
+
+// mock function of listObjectsV2()
+func (mc minioClientMock) listObjectsV2(ctx context.Context, bucketName, prefix, continuationToken, delimiter string, maxKeys int) (minio.ListBucketV2Result, error) {
+	return minioListObjectsV2Mock(ctx, bucketName, prefix, continuationToken, delimiter, maxKeys)
+}
+
+// mock function of putObject()
+func (mc minioClientMock) putObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	return minioPutObjectMock(ctx, bucketName, objectName, reader, objectSize, opts)
+}
+
+// mock function of getObject()
+func (mc minioClientMock) getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error) {
+	return minioGetObjectMock(ctx, bucketName, objectName, opts)
+}
+
+// mock function of listObjects()
+func (mc minioClientMock) listObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	return minioListObjectsMock(ctx, bucketName, opts)
+}
+
+// Define a mock of an object returned by getObject()
+type objectReaderMock struct {
+	*bytes.Reader
+	info    minio.ObjectInfo
+	statErr error
+}
+
+func (o objectReaderMock) Stat() (minio.ObjectInfo, error) {
+	return o.info, o.statErr
+}
+
+func (o objectReaderMock) Close() error {
+	return nil
+}
+
+func TestListObjects(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	bucketName := "bucket1"
+	modified := time.Now()
+
+	// Test-1: listBucketObjects() returns prefixes first and then objects
+	mockResult := minio.ListBucketV2Result{
+		CommonPrefixes: []minio.CommonPrefix{
+			{Prefix: "photos/"},
+		},
+		Contents: []minio.ObjectInfo{
+			{Key: "readme.txt", Size: 1024, LastModified: modified, ETag: "etag1", StorageClass: "STANDARD"},
+			{Key: "data.csv", Size: 2048, LastModified: modified, ETag: "etag2", StorageClass: "REDUCED_REDUNDANCY"},
+		},
+		IsTruncated:           true,
+		NextContinuationToken: "next-token",
+	}
+	minioListObjectsV2Mock = func(ctx context.Context, bucketName, prefix, continuationToken, delimiter string, maxKeys int) (minio.ListBucketV2Result, error) {
+		return mockResult, nil
+	}
+	function := "listBucketObjects()"
+	opts := listObjectsOptions{delimiter: "/", maxKeys: 1000}
+	resp, err := listBucketObjects(ctx, minClient, bucketName, opts)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(int64(3), resp.Total, fmt.Sprintf("Failed on %s: total objects is not the same", function))
+	assert.Equal(3, len(resp.Objects), fmt.Sprintf("Failed on %s: length of objects list is not the same", function))
+	assert.Equal("photos/", resp.Objects[0].Name)
+	assert.True(resp.Objects[0].IsPrefix)
+	for i, object := range resp.Objects[1:] {
+		assert.Equal(mockResult.Contents[i].Key, object.Name)
+		assert.Equal(mockResult.Contents[i].Size, object.Size)
+		assert.Equal(mockResult.Contents[i].ETag, object.Etag)
+		assert.Equal(mockResult.Contents[i].StorageClass, object.StorageClass)
+		assert.Equal(modified.Format(time.RFC3339), object.LastModified)
+		assert.False(object.IsPrefix)
+	}
+	assert.True(resp.IsTruncated)
+	assert.Equal("next-token", resp.NextContinuationToken)
+
+	// Test-2: listBucketObjects() last page doesn't return a continuation token
+	minioListObjectsV2Mock = func(ctx context.Context, bucketName, prefix, continuationToken, delimiter string, maxKeys int) (minio.ListBucketV2Result, error) {
+		return minio.ListBucketV2Result{NextContinuationToken: "ignored"}, nil
+	}
+	resp, err = listBucketObjects(ctx, minClient, bucketName, opts)
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(int64(0), resp.Total)
+	assert.False(resp.IsTruncated)
+	assert.Equal("", resp.NextContinuationToken)
+
+	// Test-3: listBucketObjects() handles errors correctly
+	minioListObjectsV2Mock = func(ctx context.Context, bucketName, prefix, continuationToken, delimiter string, maxKeys int) (minio.ListBucketV2Result, error) {
+		return minio.ListBucketV2Result{}, errors.New("error")
+	}
+	_, err = listBucketObjects(ctx, minClient, bucketName, opts)
+	if assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestGetListObjectsOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: defaults are applied when no parameters are sent
+	opts := getListObjectsOptions(user_api.ListObjectsParams{BucketName: "bucket1"})
+	assert.Equal("", opts.prefix)
+	assert.Equal(defaultObjectsDelimiter, opts.delimiter)
+	assert.Equal(defaultObjectsMaxKeys, opts.maxKeys)
+
+	// Test-2: recursive listing ignores the delimiter
+	opts = getListObjectsOptions(user_api.ListObjectsParams{
+		BucketName:        "bucket1",
+		Prefix:            swag.String("photos/"),
+		Delimiter:         swag.String("/"),
+		Recursive:         swag.Bool(true),
+		ContinuationToken: swag.String("token"),
+		MaxKeys:           swag.Int32(10),
+	})
+	assert.Equal("photos/", opts.prefix)
+	assert.Equal("", opts.delimiter)
+	assert.Equal("token", opts.continuationToken)
+	assert.Equal(10, opts.maxKeys)
+
+	// Test-3: max keys is capped to the default page size
+	opts = getListObjectsOptions(user_api.ListObjectsParams{BucketName: "bucket1", MaxKeys: swag.Int32(5000)})
+	assert.Equal(defaultObjectsMaxKeys, opts.maxKeys)
+}
+
+func TestUploadObjects(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	bucketName := "bucket1"
+
+	// builds a multipart body with a metadata field and two files, the second one
+	// sending its own content type and user metadata header
+	newBody := func() (*bytes.Buffer, string) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		_ = writer.WriteField("metadata", `{"project":"console"}`)
+		file, _ := writer.CreateFormFile("file", "notes.txt")
+		_, _ = file.Write([]byte("some notes"))
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="file"; filename="data.bin"`)
+		header.Set("Content-Type", "application/x-custom")
+		header.Set("X-Amz-Meta-Owner", "alice")
+		file, _ = writer.CreatePart(header)
+		_, _ = file.Write([]byte("binary data"))
+		_ = writer.Close()
+		return body, writer.Boundary()
+	}
+
+	// Test-1: uploadObjects() uploads every file with its content type and metadata
+	uploaded := map[string]minio.PutObjectOptions{}
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		data, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return minio.UploadInfo{}, err
+		}
+		uploaded[objectName] = opts
+		return minio.UploadInfo{Key: objectName, Size: int64(len(data)), ETag: "etag"}, nil
+	}
+	function := "uploadObjects()"
+	body, boundary := newBody()
+	resp, err := uploadObjects(ctx, minClient, bucketName, "folder/", multipart.NewReader(body, boundary))
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(int64(2), resp.Total, fmt.Sprintf("Failed on %s: total uploaded objects is not the same", function))
+	assert.Equal(int64(0), resp.Failed)
+	assert.Equal("folder/notes.txt", resp.Objects[0].Name)
+	assert.Equal(int64(len("some notes")), resp.Objects[0].Size)
+	assert.Equal("application/octet-stream", resp.Objects[0].ContentType)
+	assert.Equal("folder/data.bin", resp.Objects[1].Name)
+	assert.Equal("application/x-custom", resp.Objects[1].ContentType)
+	assert.Equal("etag", resp.Objects[1].Etag)
+	assert.Equal(map[string]string{"project": "console"}, uploaded["folder/notes.txt"].UserMetadata)
+	assert.Equal(map[string]string{"project": "console", "Owner": "alice"}, uploaded["folder/data.bin"].UserMetadata)
+
+	// Test-2: uploadObjects() reports per file errors and keeps uploading the rest
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		if objectName == "notes.txt" {
+			return minio.UploadInfo{}, errors.New("access denied")
+		}
+		return minio.UploadInfo{Key: objectName}, nil
+	}
+	body, boundary = newBody()
+	resp, err = uploadObjects(ctx, minClient, bucketName, "", multipart.NewReader(body, boundary))
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(int64(2), resp.Total)
+	assert.Equal(int64(1), resp.Failed)
+	assert.Equal("access denied", resp.Objects[0].Error)
+	assert.Equal("", resp.Objects[1].Error)
+
+	// Test-3: uploadObjects() fails on an invalid metadata field
+	body = &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("metadata", "not json")
+	_ = writer.Close()
+	_, err = uploadObjects(ctx, minClient, bucketName, "", multipart.NewReader(body, writer.Boundary()))
+	assert.Error(err)
+}
+
+func TestCreateFolder(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "createFolder()"
+
+	// Test-1: createFolder() puts an empty object with a trailing slash
+	var putName string
+	var putSize int64
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		putName = objectName
+		putSize = objectSize
+		return minio.UploadInfo{Key: objectName}, nil
+	}
+	if err := createFolder(ctx, minClient, "bucket1", "photos/2020"); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("photos/2020/", putName)
+	assert.Equal(int64(0), putSize)
+
+	// Test-2: folderObjectName() rejects invalid paths
+	for _, folderPath := range []string{"", "/", "/photos", "photos//2020", "photos/../secrets", "./photos"} {
+		_, err := folderObjectName(folderPath)
+		assert.Error(err, folderPath)
+	}
+	name, _ := folderObjectName("photos/")
+	assert.Equal("photos/", name)
+}
+
+func TestDownloadObject(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	content := []byte("0123456789")
+	info := minio.ObjectInfo{
+		Key:          "folder/numbers.txt",
+		Size:         int64(len(content)),
+		ETag:         "abc123",
+		ContentType:  "text/plain",
+		LastModified: time.Now().UTC(),
+	}
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error) {
+		return objectReaderMock{Reader: bytes.NewReader(content), info: info}, nil
+	}
+
+	// Test-1: openObject() returns the object and its information
+	function := "openObject()"
+	object, objectInfo, err := openObject(ctx, minClient, "bucket1", info.Key, "")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal(info, objectInfo)
+
+	// Test-2: serveObject() writes the whole object with its headers
+	r := httptest.NewRequest("GET", "/api/v1/buckets/bucket1/objects/download", nil)
+	w := httptest.NewRecorder()
+	serveObject(w, r, object, objectInfo)
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal("text/plain", w.Header().Get("Content-Type"))
+	assert.Equal(`attachment; filename=numbers.txt`, w.Header().Get("Content-Disposition"))
+	assert.Equal(`"abc123"`, w.Header().Get("ETag"))
+	assert.Equal(content, w.Body.Bytes())
+
+	// Test-3: serveObject() honours range requests
+	r = httptest.NewRequest("GET", "/api/v1/buckets/bucket1/objects/download", nil)
+	r.Header.Set("Range", "bytes=2-5")
+	w = httptest.NewRecorder()
+	serveObject(w, r, bytes.NewReader(content), objectInfo)
+	assert.Equal(http.StatusPartialContent, w.Code)
+	assert.Equal("bytes 2-5/10", w.Header().Get("Content-Range"))
+	assert.Equal("2345", w.Body.String())
+
+	// Test-4: serveObject() answers If-None-Match with not modified
+	r = httptest.NewRequest("GET", "/api/v1/buckets/bucket1/objects/download", nil)
+	r.Header.Set("If-None-Match", `"abc123"`)
+	w = httptest.NewRecorder()
+	serveObject(w, r, bytes.NewReader(content), objectInfo)
+	assert.Equal(http.StatusNotModified, w.Code)
+	assert.Equal(0, w.Body.Len())
+
+	// Test-5: openObject() handles stat errors correctly
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error) {
+		return objectReaderMock{Reader: bytes.NewReader(nil), statErr: errors.New("The specified key does not exist.")}, nil
+	}
+	_, _, err = openObject(ctx, minClient, "bucket1", "missing", "")
+	if assert.Error(err) {
+		assert.Equal("The specified key does not exist.", err.Error())
+	}
+}
+
+func TestDownloadObjectsZip(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	objects := map[string]string{
+		"photos/2020/a.txt":     "first",
+		"photos/2020/sub/b.txt": "second",
+	}
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 3)
+		ch <- minio.ObjectInfo{Key: "photos/2020/"}
+		ch <- minio.ObjectInfo{Key: "photos/2020/a.txt"}
+		ch <- minio.ObjectInfo{Key: "photos/2020/sub/b.txt"}
+		close(ch)
+		return ch
+	}
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (objectReader, error) {
+		return objectReaderMock{Reader: bytes.NewReader([]byte(objects[objectName]))}, nil
+	}
+
+	// Test-1: writeObjectsZip() writes every object relative to the prefix's parent folder
+	function := "writeObjectsZip()"
+	buf := &bytes.Buffer{}
+	if err := writeObjectsZip(ctx, minClient, "bucket1", "photos/2020/", buf); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(2, len(archive.File), fmt.Sprintf("Failed on %s: number of files in the archive is not the same", function))
+	for _, file := range archive.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+			continue
+		}
+		data, _ := ioutil.ReadAll(rc)
+		rc.Close()
+		assert.Equal(objects["photos/"+file.Name], string(data))
+	}
+	assert.Equal("2020/a.txt", archive.File[0].Name)
+
+	// Test-2: writeObjectsZip() returns listing errors
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 1)
+		ch <- minio.ObjectInfo{Err: errors.New("error")}
+		close(ch)
+		return ch
+	}
+	err = writeObjectsZip(ctx, minClient, "bucket1", "photos/", &bytes.Buffer{})
+	if assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+
+	// Test-3: writeObjectsZip() lists a prefix naming a folder as a folder, excluding its siblings
+	var listOpts minio.ListObjectsOptions
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		listOpts = opts
+		ch := make(chan minio.ObjectInfo)
+		close(ch)
+		return ch
+	}
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{}, minio.ErrorResponse{Code: "NoSuchKey"}
+	}
+	if err = writeObjectsZip(ctx, minClient, "bucket1", "photos", &bytes.Buffer{}); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("photos/", listOpts.Prefix)
+
+	// Test-4: writeObjectsZip() archives only the object named by the prefix
+	listOpts = minio.ListObjectsOptions{}
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{Key: objectName, Size: 5}, nil
+	}
+	buf = &bytes.Buffer{}
+	if err = writeObjectsZip(ctx, minClient, "bucket1", "photos/2020/a.txt", buf); err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	archive, err = zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if assert.NoError(err) && assert.Equal(1, len(archive.File)) {
+		assert.Equal("a.txt", archive.File[0].Name)
+	}
+	assert.Equal("", listOpts.Prefix)
+
+	// Test-5: zipArchiveName() uses the last folder or the bucket name
+	assert.Equal("2020.zip", zipArchiveName("bucket1", "photos/2020/"))
+	assert.Equal("bucket1.zip", zipArchiveName("bucket1", ""))
+}
@@ -0,0 +1,167 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+)
+
+func registerObjectVersionsHandlers(api *operations.ConsoleAPI) {
+	// list object versions
+	api.UserAPIListObjectVersionsHandler = user_api.ListObjectVersionsHandlerFunc(func(params user_api.ListObjectVersionsParams, session *models.Principal) middleware.Responder {
+		listObjectVersionsResponse, err := getListObjectVersionsResponse(session, params)
+		if err != nil {
+			return user_api.NewListObjectVersionsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewListObjectVersionsOK().WithPayload(listObjectVersionsResponse)
+	})
+	// restore object version
+	api.UserAPIRestoreObjectVersionHandler = user_api.RestoreObjectVersionHandlerFunc(func(params user_api.RestoreObjectVersionParams, session *models.Principal) middleware.Responder {
+		objectVersion, err := getRestoreObjectVersionResponse(session, params)
+		if err != nil {
+			return user_api.NewRestoreObjectVersionDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+		}
+		return user_api.NewRestoreObjectVersionCreated().WithPayload(objectVersion)
+	})
+}
+
+// listObjectVersions returns every version and delete marker of an object, newest first
+func listObjectVersions(ctx context.Context, client MinioClient, bucketName, objectName string) (*models.ListObjectVersionsResponse, error) {
+	opts := minio.ListObjectsOptions{
+		Prefix:       objectName,
+		Recursive:    true,
+		WithVersions: true,
+	}
+	versions := []*models.ObjectVersion{}
+	for object := range client.listObjects(ctx, bucketName, opts) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		// the listing is done by prefix so other objects starting with the same name are skipped
+		if object.Key != objectName {
+			continue
+		}
+		versions = append(versions, &models.ObjectVersion{
+			Name:           object.Key,
+			VersionID:      object.VersionID,
+			IsLatest:       object.IsLatest,
+			IsDeleteMarker: object.IsDeleteMarker,
+			Size:           object.Size,
+			LastModified:   object.LastModified.Format(time.RFC3339),
+			Etag:           object.ETag,
+		})
+	}
+	return &models.ListObjectVersionsResponse{
+		Versions: versions,
+		Total:    int64(len(versions)),
+	}, nil
+}
+
+// getListObjectVersionsResponse performs listObjectVersions() and serializes it to the handler's output
+func getListObjectVersionsResponse(session *models.Principal, params user_api.ListObjectVersionsParams) (*models.ListObjectVersionsResponse, error) {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return nil, errors.New(500, "error object name not in request")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	versions, err := listObjectVersions(ctx, minioClient, params.BucketName, *params.Prefix)
+	if err != nil {
+		log.Println("error listing object versions:", err)
+		return nil, err
+	}
+	return versions, nil
+}
+
+// restoreObjectVersion makes an older version the latest one by copying it over the object
+// on the server side, the copy is a new version so the history is kept untouched.
+func restoreObjectVersion(ctx context.Context, client MinioClient, bucketName, objectName, versionID string) (*models.ObjectVersion, error) {
+	// the size decides whether the version can be copied at once or has to be composed
+	stat, err := client.statObject(ctx, bucketName, objectName, minio.StatObjectOptions{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	dst := minio.CopyDestOptions{
+		Bucket: bucketName,
+		Object: objectName,
+	}
+	src := minio.CopySrcOptions{
+		Bucket:    bucketName,
+		Object:    objectName,
+		VersionID: versionID,
+	}
+	info, err := copyObjectServerSide(ctx, client, src, dst, stat.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ObjectVersion{
+		Name:         objectName,
+		VersionID:    info.VersionID,
+		IsLatest:     true,
+		Size:         info.Size,
+		LastModified: info.LastModified.Format(time.RFC3339),
+		Etag:         info.ETag,
+	}, nil
+}
+
+// getRestoreObjectVersionResponse performs restoreObjectVersion() and serializes it to the handler's output
+func getRestoreObjectVersionResponse(session *models.Principal, params user_api.RestoreObjectVersionParams) (*models.ObjectVersion, error) {
+	if params.Prefix == nil || *params.Prefix == "" {
+		log.Println("error object name not in request")
+		return nil, errors.New(500, "error object name not in request")
+	}
+	if params.VersionID == nil || *params.VersionID == "" {
+		log.Println("error version id not in request")
+		return nil, errors.New(500, "error version id not in request")
+	}
+	ctx := context.Background()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		return nil, err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	objectVersion, err := restoreObjectVersion(ctx, minioClient, params.BucketName, *params.Prefix, *params.VersionID)
+	if err != nil {
+		log.Println("error restoring object version:", err)
+		return nil, err
+	}
+	return objectVersion, nil
+}
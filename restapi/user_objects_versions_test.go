@@ -0,0 +1,160 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioCopyObjectMock func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+
+// mock function of copyObject()
+func (mc minioClientMock) copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return minioCopyObjectMock(ctx, dst, src)
+}
+
+func TestListObjectVersions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "listObjectVersions()"
+
+	var listOpts minio.ListObjectsOptions
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		listOpts = opts
+		ch := make(chan minio.ObjectInfo, 4)
+		ch <- minio.ObjectInfo{Key: "report.pdf", VersionID: "v3", IsLatest: true, IsDeleteMarker: true}
+		ch <- minio.ObjectInfo{Key: "report.pdf", VersionID: "v2", Size: 20, ETag: "etag2"}
+		ch <- minio.ObjectInfo{Key: "report.pdf", VersionID: "v1", Size: 10, ETag: "etag1"}
+		ch <- minio.ObjectInfo{Key: "report.pdf.bak", VersionID: "v1", IsLatest: true}
+		close(ch)
+		return ch
+	}
+
+	// Test-1: listObjectVersions() returns versions and delete markers of the object only
+	versions, err := listObjectVersions(ctx, minClient, "bucket1", "report.pdf")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.True(listOpts.WithVersions)
+	assert.Equal("report.pdf", listOpts.Prefix)
+	if assert.Equal(int64(3), versions.Total, fmt.Sprintf("Failed on %s: number of versions is not the same", function)) {
+		assert.True(versions.Versions[0].IsDeleteMarker)
+		assert.True(versions.Versions[0].IsLatest)
+		assert.Equal("v2", versions.Versions[1].VersionID)
+		assert.Equal(int64(20), versions.Versions[1].Size)
+		assert.False(versions.Versions[1].IsLatest)
+	}
+
+	// Test-2: listObjectVersions() handles listing errors correctly
+	minioListObjectsMock = func(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 1)
+		ch <- minio.ObjectInfo{Err: errors.New("error")}
+		close(ch)
+		return ch
+	}
+	if _, err = listObjectVersions(ctx, minClient, "bucket1", "report.pdf"); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
+
+func TestRestoreObjectVersion(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	function := "restoreObjectVersion()"
+
+	// Test-1: restoreObjectVersion() copies the version over the object
+	var gotDst minio.CopyDestOptions
+	var gotSrc minio.CopySrcOptions
+	lastModified := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	var statVersions []string
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		statVersions = append(statVersions, opts.VersionID)
+		return minio.ObjectInfo{Key: objectName, VersionID: opts.VersionID, Size: 10}, nil
+	}
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		gotDst = dst
+		gotSrc = src
+		return minio.UploadInfo{Bucket: dst.Bucket, Key: dst.Object, VersionID: "v4", Size: 10, ETag: "etag1", LastModified: lastModified}, nil
+	}
+	version, err := restoreObjectVersion(ctx, minClient, "bucket1", "report.pdf", "v1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal(minio.CopyDestOptions{Bucket: "bucket1", Object: "report.pdf"}, gotDst)
+	assert.Equal(minio.CopySrcOptions{Bucket: "bucket1", Object: "report.pdf", VersionID: "v1"}, gotSrc)
+	assert.Equal([]string{"v1"}, statVersions)
+	assert.Equal("v4", version.VersionID)
+	assert.True(version.IsLatest)
+	assert.Equal("2020-08-01T10:00:00Z", version.LastModified)
+
+	// Test-2: restoreObjectVersion() handles copy errors correctly
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		return minio.UploadInfo{}, errors.New("The specified version does not exist.")
+	}
+	if _, err = restoreObjectVersion(ctx, minClient, "bucket1", "report.pdf", "v9"); assert.Error(err) {
+		assert.Equal("The specified version does not exist.", err.Error())
+	}
+
+	// Test-3: restoreObjectVersion() composes versions too big for CopyObject from the same version
+	statVersions = nil
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		statVersions = append(statVersions, opts.VersionID)
+		return minio.ObjectInfo{Key: objectName, VersionID: opts.VersionID, Size: maxCopyObjectSize + 1, ContentType: "application/pdf"}, nil
+	}
+	var taggedVersion string
+	minioGetObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+		taggedVersion = opts.VersionID
+		return tags.MapToObjectTags(map[string]string{})
+	}
+	var composedSrcs []minio.CopySrcOptions
+	minioComposeObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+		gotDst = dst
+		composedSrcs = srcs
+		return minio.UploadInfo{Bucket: dst.Bucket, Key: dst.Object, VersionID: "v5", Size: maxCopyObjectSize + 1, LastModified: lastModified}, nil
+	}
+	version, err = restoreObjectVersion(ctx, minClient, "bucket1", "report.pdf", "v2")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+		return
+	}
+	assert.Equal([]string{"v2", "v2"}, statVersions)
+	assert.Equal("v2", taggedVersion)
+	assert.Equal([]minio.CopySrcOptions{{Bucket: "bucket1", Object: "report.pdf", VersionID: "v2"}}, composedSrcs)
+	assert.Equal("application/pdf", gotDst.UserMetadata["Content-Type"])
+	assert.Equal("v5", version.VersionID)
+	assert.Equal(int64(maxCopyObjectSize+1), version.Size)
+
+	// Test-4: restoreObjectVersion() handles stat errors correctly
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{}, errors.New("The specified version does not exist.")
+	}
+	if _, err = restoreObjectVersion(ctx, minClient, "bucket1", "report.pdf", "v9"); assert.Error(err) {
+		assert.Equal("The specified version does not exist.", err.Error())
+	}
+}
@@ -17,7 +17,11 @@
 package restapi
 
 import (
+	"context"
 	"errors"
+	"log"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
@@ -31,6 +35,62 @@ var (
 	errorGenericInvalidSession = errors.New("invalid session")
 )
 
+// maxSessionAccounts limits the entries kept by sessionAccountsCache, it's emptied once reached
+const maxSessionAccounts = 10000
+
+// sessionAccountsCache remembers the account owning each session access key, session credentials
+// are temporary and change on every login while the account they belong to doesn't
+type sessionAccountsCache struct {
+	sync.Mutex
+	accounts map[string]string
+}
+
+// globalSessionAccounts caches the accounts resolved by getSessionAccountName
+var globalSessionAccounts = &sessionAccountsCache{}
+
+// getAccountName returns the user owning the session credentials, for STS credentials MinIO reports
+// the parent user. It's the identity used to record who owns shares and operations.
+func getAccountName(ctx context.Context, client MinioAdmin, cache *sessionAccountsCache, accessKeyID string) (string, error) {
+	cache.Lock()
+	account, ok := cache.accounts[accessKeyID]
+	cache.Unlock()
+	if ok {
+		return account, nil
+	}
+	info, err := client.accountUsageInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.accounts == nil || len(cache.accounts) >= maxSessionAccounts {
+		cache.accounts = make(map[string]string)
+	}
+	cache.accounts[accessKeyID] = info.AccountName
+	return info.AccountName, nil
+}
+
+// getSessionAccountName performs getAccountName() for the session
+func getSessionAccountName(session *models.Principal) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	mAdmin, err := newMAdminClient(session)
+	if err != nil {
+		log.Println("error creating Madmin Client:", err)
+		return "", err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := adminClient{client: mAdmin}
+
+	account, err := getAccountName(ctx, adminClient, globalSessionAccounts, session.AccessKeyID)
+	if err != nil {
+		log.Println("error getting session account:", err)
+		return "", err
+	}
+	return account, nil
+}
+
 func registerSessionHandlers(api *operations.ConsoleAPI) {
 	// session check
 	api.UserAPISessionCheckHandler = user_api.SessionCheckHandlerFunc(func(params user_api.SessionCheckParams, session *models.Principal) middleware.Responder {
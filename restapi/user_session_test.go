@@ -0,0 +1,63 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountName(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := adminClientMock{}
+	cache := &sessionAccountsCache{}
+	function := "getAccountName()"
+
+	calls := 0
+	minioAccountUsageInfoMock = func(ctx context.Context) (madmin.AccountUsageInfo, error) {
+		calls++
+		return madmin.AccountUsageInfo{AccountName: "alice"}, nil
+	}
+
+	// Test-1: getAccountName() returns the account owning the session credentials
+	account, err := getAccountName(ctx, adminClient, cache, "STSKEY1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("alice", account)
+
+	// Test-2: getAccountName() resolves each session key once
+	account, err = getAccountName(ctx, adminClient, cache, "STSKEY1")
+	if err != nil {
+		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
+	}
+	assert.Equal("alice", account)
+	assert.Equal(1, calls)
+
+	// Test-3: getAccountName() handles errors correctly
+	minioAccountUsageInfoMock = func(ctx context.Context) (madmin.AccountUsageInfo, error) {
+		return madmin.AccountUsageInfo{}, errors.New("error")
+	}
+	if _, err = getAccountName(ctx, adminClient, cache, "STSKEY2"); assert.Error(err) {
+		assert.Equal("error", err.Error())
+	}
+}
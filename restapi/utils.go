@@ -70,6 +70,23 @@ func DifferenceArrays(a, b []string) []string {
 	return diff
 }
 
+// sameNames returns true if both slices have the same elements in any order
+func sameNames(a, b []string) bool {
+	return len(DifferenceArrays(a, b)) == 0 && len(DifferenceArrays(b, a)) == 0
+}
+
+// splitPolicyNames returns the policies of a comma separated list, MinIO stores multiple
+// policies of an user or group that way
+func splitPolicyNames(names string) []string {
+	var result []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
 // IsElementInArray returns true if the string belongs to the slice
 func IsElementInArray(a []string, b string) bool {
 	for _, e := range a {
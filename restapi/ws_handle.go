@@ -56,6 +56,8 @@ type wsAdminClient struct {
 type ConsoleWebsocket interface {
 	watch(options watchOptions)
 	heal(opts healOptions)
+	deleteObjects(opts *deleteObjectsOptions)
+	searchObjects(opts *searchObjectsOptions)
 }
 
 type wsS3Client struct {
@@ -65,6 +67,13 @@ type wsS3Client struct {
 	client MCClient
 }
 
+type wsMinioClient struct {
+	// websocket connection.
+	conn wsConn
+	// MinIO Client
+	client MinioClient
+}
+
 // WSConn interface with all functions to be implemented
 // by mock when testing, it should include all websocket.Conn
 // respective api calls that are used within this project.
@@ -152,6 +161,32 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 		go wsS3Client.watch(wOptions)
+	case strings.HasPrefix(wsPath, `/delete`):
+		dOptions, err := getDeleteObjectsOptionsFromReq(req)
+		if err != nil {
+			log.Println("error getting delete options:", err)
+			closeWsConn(conn)
+			return
+		}
+		wsMinioClient, err := newWebSocketMinioClient(conn, session)
+		if err != nil {
+			closeWsConn(conn)
+			return
+		}
+		go wsMinioClient.deleteObjects(dOptions)
+	case wsPath == "/search":
+		sOptions, err := getSearchObjectsOptionsFromReq(req)
+		if err != nil {
+			log.Println("error getting search options:", err)
+			closeWsConn(conn)
+			return
+		}
+		wsMinioClient, err := newWebSocketMinioClient(conn, session)
+		if err != nil {
+			closeWsConn(conn)
+			return
+		}
+		go wsMinioClient.searchObjects(sOptions)
 	default:
 		// path not found
 		closeWsConn(conn)
@@ -203,6 +238,28 @@ func newWebSocketS3Client(conn *websocket.Conn, claims *models.Principal, bucket
 	return wsS3Client, nil
 }
 
+// newWebSocketMinioClient returns a wsMinioClient authenticated as the session user
+func newWebSocketMinioClient(conn *websocket.Conn, claims *models.Principal) (*wsMinioClient, error) {
+	// Only start Websocket Interaction after user has been
+	// authenticated with MinIO
+	mClient, err := newMinioClient(claims)
+	if err != nil {
+		log.Println("error creating MinIO Client:", err)
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		conn.Close()
+		return nil, err
+	}
+	// create a websocket connection interface implementation
+	// defining the connection to be used
+	wsConnection := wsConn{conn: conn}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	// create websocket client and handle request
+	wsMinioClient := &wsMinioClient{conn: wsConnection, client: minioClient}
+	return wsMinioClient, nil
+}
+
 // wsReadClientCtx reads the messages that come from the client
 // if the client sends a Close Message the context will be
 // canceled. If the connection is closed the goroutine inside
@@ -304,6 +361,36 @@ func (wsc *wsAdminClient) heal(opts *healOptions) {
 	sendWsCloseMessage(wsc.conn, err)
 }
 
+func (wsc *wsMinioClient) deleteObjects(opts *deleteObjectsOptions) {
+	defer func() {
+		log.Println("delete objects stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	log.Println("delete objects started")
+
+	ctx := wsReadClientCtx(wsc.conn)
+
+	err := startDeleteObjects(ctx, wsc.conn, wsc.client, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
+func (wsc *wsMinioClient) searchObjects(opts *searchObjectsOptions) {
+	defer func() {
+		log.Println("search objects stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	log.Println("search objects started")
+
+	ctx := wsReadClientCtx(wsc.conn)
+
+	err := startSearchObjects(ctx, wsc.conn, wsc.client, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
 // sendWsCloseMessage sends Websocket Connection Close Message indicating the Status Code
 // see https://tools.ietf.org/html/rfc6455#page-45
 func sendWsCloseMessage(conn WSConn, err error) {